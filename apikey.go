@@ -0,0 +1,212 @@
+package zeal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const keyFileCheckInterval = time.Second
+
+var ErrKeyNotFound = errors.New("API key not found")
+
+type Principal struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Scopes   []string          `json:"scopes,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+type KeyStore interface {
+	Lookup(ctx context.Context, key string) (Principal, error)
+}
+
+type APIKeyAuthenticator struct {
+	store KeyStore
+}
+
+func NewAPIKeyAuthenticator(store KeyStore) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{store: store}
+}
+
+// AddAPIKeyScheme declares an API key scheme, read from the header, query param or cookie keyName,
+// and authenticated by looking keys up in the store
+func (m *ZealMux) AddAPIKeyScheme(name, in, keyName string, store KeyStore) {
+	m.AddSecurityScheme(name, NewAPIKeyScheme(in, keyName, NewAPIKeyAuthenticator(store)))
+}
+
+func (a *APIKeyAuthenticator) Authenticate(r *http.Request, credential string, scopes []string) (context.Context, error) {
+	principal, err := a.store.Lookup(r.Context(), credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(principal.Scopes, scope) {
+			return nil, fmt.Errorf("%w: missing scope %v", ErrForbidden, scope)
+		}
+	}
+
+	return context.WithValue(r.Context(), principalContextKey{}, principal), nil
+}
+
+type principalContextKey struct{}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+func HashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+type MemoryKeyStore struct {
+	principals map[string]Principal
+	mutex      sync.RWMutex
+}
+
+func NewMemoryKeyStore() *MemoryKeyStore {
+	return &MemoryKeyStore{principals: make(map[string]Principal)}
+}
+
+func (s *MemoryKeyStore) Add(key string, principal Principal) {
+	s.AddHash(HashAPIKey(key), principal)
+}
+
+func (s *MemoryKeyStore) AddHash(hash string, principal Principal) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.principals[strings.ToLower(hash)] = principal
+}
+
+func (s *MemoryKeyStore) Remove(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.principals, HashAPIKey(key))
+}
+
+func (s *MemoryKeyStore) Lookup(ctx context.Context, key string) (Principal, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	principal, ok := s.principals[HashAPIKey(key)]
+	if !ok {
+		return Principal{}, ErrKeyNotFound
+	}
+
+	return principal, nil
+}
+
+type FileKeyStore struct {
+	path        string
+	modTime     time.Time
+	lastChecked time.Time
+	memory      *MemoryKeyStore
+	mutex       sync.Mutex
+}
+
+type keyFile struct {
+	Keys []struct {
+		Hash string `json:"hash"`
+		Principal
+	} `json:"keys"`
+}
+
+func NewFileKeyStore(path string) (*FileKeyStore, error) {
+	store := &FileKeyStore{path: path}
+	if err := store.Reload(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *FileKeyStore) Reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+
+	keyFileBytes, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+
+	var keys keyFile
+	if err := json.Unmarshal(keyFileBytes, &keys); err != nil {
+		return fmt.Errorf("failed to parse key file %v: %w", s.path, err)
+	}
+
+	memory := NewMemoryKeyStore()
+	for _, key := range keys.Keys {
+		if key.Hash == "" {
+			return fmt.Errorf("expected key hash in key file %v, received none for: %v", s.path, key.ID)
+		}
+		memory.AddHash(key.Hash, key.Principal)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.memory = memory
+	s.modTime = info.ModTime()
+
+	return nil
+}
+
+func (s *FileKeyStore) Lookup(ctx context.Context, key string) (Principal, error) {
+	if s.shouldCheck() {
+		s.reloadIfChanged()
+	}
+
+	s.mutex.Lock()
+	memory := s.memory
+	s.mutex.Unlock()
+
+	return memory.Lookup(ctx, key)
+}
+
+// shouldCheck is true for one lookup per interval, so the file isn't read on every request
+func (s *FileKeyStore) shouldCheck() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if time.Since(s.lastChecked) < keyFileCheckInterval {
+		return false
+	}
+	s.lastChecked = time.Now()
+
+	return true
+}
+
+// reloadIfChanged keeps the last good keys if the changed file can't be loaded
+func (s *FileKeyStore) reloadIfChanged() {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		log.Printf("zeal: failed to check key file: %v", err)
+		return
+	}
+
+	s.mutex.Lock()
+	changed := !info.ModTime().Equal(s.modTime)
+	s.mutex.Unlock()
+
+	if !changed {
+		return
+	}
+
+	if err := s.Reload(); err != nil {
+		log.Printf("zeal: failed to reload key file: %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package zeal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryKeyStore(t *testing.T) {
+	store := NewMemoryKeyStore()
+	store.Add("first-key", Principal{ID: "first"})
+	store.AddHash(strings.ToUpper(HashAPIKey("second-key")), Principal{ID: "second"})
+
+	for key, id := range map[string]string{"first-key": "first", "second-key": "second"} {
+		principal, err := store.Lookup(context.Background(), key)
+		if err != nil || principal.ID != id {
+			t.Errorf("expected principal %v for %v, received: %v, %v", id, key, principal, err)
+		}
+	}
+
+	if _, err := store.Lookup(context.Background(), HashAPIKey("first-key")); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected the hash itself not to be accepted as a key, received: %v", err)
+	}
+
+	store.Remove("first-key")
+	if _, err := store.Lookup(context.Background(), "first-key"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected a removed key to be rejected, received: %v", err)
+	}
+}
+
+func writeTestKeyFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testKeyFile(key, id string) string {
+	return fmt.Sprintf(`{"keys": [{"hash": %q, "id": %q, "scopes": ["read"]}]}`, HashAPIKey(key), id)
+}
+
+func TestFileKeyStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	modTime := time.Now().Add(-time.Hour)
+	writeTestKeyFile(t, path, testKeyFile("old-key", "old"), modTime)
+
+	store, err := NewFileKeyStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lookup := func(key string) (Principal, error) {
+		return store.Lookup(context.Background(), key)
+	}
+
+	if principal, err := lookup("old-key"); err != nil || principal.ID != "old" || principal.Scopes[0] != "read" {
+		t.Fatalf("expected the key from the file, received: %v, %v", principal, err)
+	}
+
+	// The file was checked by the lookup, so a rewrite isn't seen until the interval passes
+	modTime = modTime.Add(time.Minute)
+	writeTestKeyFile(t, path, testKeyFile("new-key", "new"), modTime)
+	if _, err := lookup("old-key"); err != nil {
+		t.Fatalf("expected the file to be checked at most once per interval, received: %v", err)
+	}
+
+	store.lastChecked = time.Now().Add(-keyFileCheckInterval)
+	if principal, err := lookup("new-key"); err != nil || principal.ID != "new" {
+		t.Fatalf("expected the rewritten file to be reloaded, received: %v, %v", principal, err)
+	}
+	if _, err := lookup("old-key"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("expected keys removed from the file to be rejected, received: %v", err)
+	}
+
+	for _, content := range []string{`{"keys": [`, `{"keys": [{"id": "missing hash"}]}`} {
+		modTime = modTime.Add(time.Minute)
+		writeTestKeyFile(t, path, content, modTime)
+		store.lastChecked = time.Now().Add(-keyFileCheckInterval)
+
+		if principal, err := lookup("new-key"); err != nil || principal.ID != "new" {
+			t.Fatalf("expected the last good keys to be kept when the file is rewritten with %q, received: %v, %v", content, principal, err)
+		}
+	}
+
+	if err := store.Reload(); err == nil {
+		t.Fatal("expected reloading bad content to fail, received no error")
+	}
+}
+
+func TestNewFileKeyStoreRejectsBadFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewFileKeyStore(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected a missing key file to fail, received no error")
+	}
+
+	path := filepath.Join(dir, "keys.json")
+	writeTestKeyFile(t, path, "not json", time.Now())
+	if _, err := NewFileKeyStore(path); err == nil {
+		t.Error("expected a malformed key file to fail, received no error")
+	}
+}
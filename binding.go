@@ -0,0 +1,114 @@
+package zeal
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+type requestBinder interface {
+	compileBinding()
+	bindRequest(r *http.Request) error
+}
+
+type responseBinder interface {
+	compileBinding()
+	bindResponse(w *http.ResponseWriter)
+}
+
+type bindingPlan struct {
+	requestBinders []requestBinder
+	responseBinder responseBinder
+}
+
+func newBindingPlan(routeValue reflect.Value) bindingPlan {
+	plan := bindingPlan{}
+	if routeValue.Kind() != reflect.Struct {
+		return plan
+	}
+
+	for _, instance := range []any{HasClaims[any]{}, HasParams[any]{}, HasBody[any]{}} {
+		field := routeValue.FieldByName(getTypeName(instance))
+		if !field.IsValid() {
+			continue
+		}
+
+		binder := field.Addr().Interface().(requestBinder)
+		binder.compileBinding()
+		plan.requestBinders = append(plan.requestBinders, binder)
+	}
+
+	responseField := routeValue.FieldByName(getTypeName(HasResponse[any]{}))
+	if responseField.IsValid() {
+		plan.responseBinder = responseField.Addr().Interface().(responseBinder)
+		plan.responseBinder.compileBinding()
+	}
+
+	return plan
+}
+
+func (plan bindingPlan) bind(w http.ResponseWriter, r *http.Request) error {
+	for _, binder := range plan.requestBinders {
+		if err := binder.bindRequest(r); err != nil {
+			return err
+		}
+	}
+
+	if plan.responseBinder != nil {
+		plan.responseBinder.bindResponse(&w)
+	}
+
+	return nil
+}
+
+type paramsPlan []paramField
+
+type paramField struct {
+	name  string
+	index []int
+	parse fieldParser
+}
+
+func newParamsPlan(paramsType reflect.Type) paramsPlan {
+	if paramsType == nil || paramsType.Kind() != reflect.Struct {
+		return paramsPlan{}
+	}
+
+	plan := paramsPlan{}
+	for i := 0; i < paramsType.NumField(); i++ {
+		field := paramsType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		plan = append(plan, paramField{
+			name:  field.Name,
+			index: field.Index,
+			parse: newFieldParser(field.Type),
+		})
+	}
+
+	return plan
+}
+
+// bind sets the fields of params, which must be a settable struct of the plan's type
+func (plan paramsPlan) bind(r *http.Request, params reflect.Value) error {
+	var query url.Values
+	var err error
+
+	for _, field := range plan {
+		rawParamValue := r.PathValue(field.name)
+		if rawParamValue == "" {
+			if query == nil {
+				query = r.URL.Query()
+			}
+			rawParamValue = query.Get(field.name)
+		}
+
+		if parseErr := field.parse(rawParamValue, params.FieldByIndex(field.index)); parseErr != nil {
+			err = parseErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,251 @@
+package zeal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type benchParams struct {
+	ID    int `in:"path"`
+	Name  string
+	Limit uint8
+	Fuzzy bool
+}
+
+type benchBody struct {
+	Name  string
+	Price float64
+	Tags  []string
+}
+
+type benchParamsRoute struct {
+	Route
+	HasParams[benchParams]
+	HasResponse[benchBody]
+}
+
+type benchBodyRoute struct {
+	Route
+	HasBody[benchBody]
+	HasResponse[benchBody]
+}
+
+type benchCombinedRoute struct {
+	Route
+	HasParams[benchParams]
+	HasBody[benchBody]
+	HasResponse[benchBody]
+}
+
+type legacyParamsRoute struct {
+	Route
+	LegacyParams[benchParams]
+	LegacyResponse[benchBody]
+}
+
+type legacyBodyRoute struct {
+	Route
+	LegacyBody[benchBody]
+	LegacyResponse[benchBody]
+}
+
+type legacyCombinedRoute struct {
+	Route
+	LegacyParams[benchParams]
+	LegacyBody[benchBody]
+	LegacyResponse[benchBody]
+}
+
+func BenchmarkBindParams(b *testing.B) {
+	benchmarkPlan(b, &benchParamsRoute{}, false)
+}
+
+func BenchmarkBindParamsReflect(b *testing.B) {
+	benchmarkReflect(b, &legacyParamsRoute{}, false)
+}
+
+func BenchmarkBindBody(b *testing.B) {
+	benchmarkPlan(b, &benchBodyRoute{}, true)
+}
+
+func BenchmarkBindBodyReflect(b *testing.B) {
+	benchmarkReflect(b, &legacyBodyRoute{}, true)
+}
+
+func BenchmarkBindCombined(b *testing.B) {
+	benchmarkPlan(b, &benchCombinedRoute{}, true)
+}
+
+func BenchmarkBindCombinedReflect(b *testing.B) {
+	benchmarkReflect(b, &legacyCombinedRoute{}, true)
+}
+
+func benchmarkPlan(b *testing.B, route any, hasBody bool) {
+	plan := newBindingPlan(reflect.ValueOf(route).Elem())
+	w := httptest.NewRecorder()
+	r, body := newBenchRequest(hasBody)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := plan.bind(w, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkReflect(b *testing.B, route any, hasBody bool) {
+	routeValue := reflect.ValueOf(route).Elem()
+	w := httptest.NewRecorder()
+	r, body := newBenchRequest(hasBody)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := legacyInitRoute(routeValue, w, r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newBenchRequest(hasBody bool) (*http.Request, []byte) {
+	var body []byte
+	if hasBody {
+		body, _ = json.Marshal(benchBody{Name: "Pizza", Price: 12.5, Tags: []string{"hot", "cheesy"}})
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/items/42?Name=margherita&Limit=10&Fuzzy=true", nil)
+	r.SetPathValue("ID", "42")
+
+	return r, body
+}
+
+// legacyInitRoute is the reflection path which preceded binding plans, kept to benchmark them against
+func legacyInitRoute(routeValue reflect.Value, w http.ResponseWriter, r *http.Request) error {
+	for _, name := range []string{"LegacyParams", "LegacyBody"} {
+		field := routeValue.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+
+		results := field.Addr().MethodByName("Validate").Call([]reflect.Value{reflect.ValueOf(r)})
+		if err := results[1].Interface(); err != nil {
+			return err.(error)
+		}
+	}
+
+	responseField := routeValue.FieldByName("LegacyResponse")
+	if responseField.IsValid() {
+		responseField.Addr().MethodByName("Validate").Call([]reflect.Value{reflect.ValueOf(&w)})
+	}
+
+	return nil
+}
+
+type LegacyParams[T_Params any] struct {
+	request *http.Request
+}
+
+func (p *LegacyParams[T_Params]) Validate(request *http.Request) (T_Params, error) {
+	p.request = request
+
+	var params T_Params
+	paramsType := reflect.TypeOf(params)
+	paramsValue := reflect.New(paramsType).Elem()
+
+	var err error
+	for i := 0; i < paramsType.NumField(); i++ {
+		field := paramsType.Field(i)
+		structField := paramsValue.FieldByName(field.Name)
+		if structField.CanSet() {
+			rawParamValue := p.request.PathValue(field.Name)
+			if rawParamValue == "" {
+				rawParamValue = p.request.URL.Query().Get(field.Name)
+			}
+			paramValue, parseErr := legacyParsePrimitive(rawParamValue, field.Type)
+			if parseErr != nil {
+				err = parseErr
+				continue
+			}
+
+			structField.Set(reflect.ValueOf(paramValue))
+		}
+	}
+
+	return paramsValue.Interface().(T_Params), err
+}
+
+func legacyParsePrimitive(rawValue any, valueType reflect.Type) (any, error) {
+	value := fmt.Sprintf("%v", rawValue)
+
+	switch valueType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || !isIntInRange(val, valueType.Kind()) {
+			return nil, fmt.Errorf("failed to parse integer from: %v", value)
+		}
+		return reflect.ValueOf(val).Convert(valueType).Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || !isUintInRange(val, valueType.Kind()) {
+			return nil, fmt.Errorf("failed to parse unsigned integer from: %v", value)
+		}
+		return reflect.ValueOf(val).Convert(valueType).Interface(), nil
+	case reflect.Float32, reflect.Float64:
+		val, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse float from: %v", value)
+		}
+		return reflect.ValueOf(val).Convert(valueType).Interface(), nil
+	case reflect.Bool:
+		val, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse boolean from: %v", value)
+		}
+		return val, nil
+	case reflect.String:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %v", valueType.Kind())
+	}
+}
+
+type LegacyBody[T_Body any] struct {
+	request *http.Request
+}
+
+func (b *LegacyBody[T_Body]) Validate(request *http.Request) (T_Body, error) {
+	b.request = request
+
+	var body T_Body
+	defer b.request.Body.Close()
+	bodyBytes, err := io.ReadAll(b.request.Body)
+	if err != nil {
+		return body, nil
+	}
+
+	b.request.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+	decoder := json.NewDecoder(bytes.NewReader(bodyBytes))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&body)
+
+	return body, err
+}
+
+type LegacyResponse[T_Response any] struct {
+	responseWriter *http.ResponseWriter
+}
+
+func (r *LegacyResponse[T_Response]) Validate(responseWriter *http.ResponseWriter) {
+	r.responseWriter = responseWriter
+}
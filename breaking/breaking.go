@@ -0,0 +1,492 @@
+package breaking
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type Change struct {
+	Rule      string `json:"rule"`
+	Breaking  bool   `json:"breaking"`
+	Operation string `json:"operation"`
+	Location  string `json:"location,omitempty"`
+	Message   string `json:"message"`
+}
+
+func (c Change) String() string {
+	severity := "non-breaking"
+	if c.Breaking {
+		severity = "breaking"
+	}
+
+	location := c.Operation
+	if c.Location != "" {
+		location += " " + c.Location
+	}
+
+	return fmt.Sprintf("%-12v %v: %v", severity, location, c.Message)
+}
+
+func HasBreaking(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(change Change) bool {
+		return change.Breaking
+	})
+}
+
+func Compare(oldSpec, newSpec *openapi3.T) []Change {
+	c := &comparison{oldSpec: oldSpec, newSpec: newSpec}
+
+	oldOperations, newOperations := getOperations(oldSpec), getOperations(newSpec)
+	for key, oldOperation := range oldOperations {
+		newOperation, ok := newOperations[key]
+		if !ok {
+			c.add(true, "operation-removed", key, "", "removed operation")
+			continue
+		}
+
+		c.compareOperation(key, oldOperation, newOperation)
+	}
+
+	for key := range newOperations {
+		if _, ok := oldOperations[key]; !ok {
+			c.add(false, "operation-added", key, "", "added operation")
+		}
+	}
+
+	slices.SortFunc(c.changes, func(a, b Change) int {
+		if a.Breaking != b.Breaking {
+			if a.Breaking {
+				return -1
+			}
+			return 1
+		}
+
+		return strings.Compare(a.Operation+" "+a.Location+" "+a.Message, b.Operation+" "+b.Location+" "+b.Message)
+	})
+
+	return c.changes
+}
+
+type operation struct {
+	*openapi3.Operation
+	parameters map[string]*openapi3.Parameter
+}
+
+func getOperations(spec *openapi3.T) map[string]operation {
+	operations := make(map[string]operation)
+	if spec.Paths == nil {
+		return operations
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, op := range pathItem.Operations() {
+			parameters := make(map[string]*openapi3.Parameter)
+			for _, parameterRefs := range []openapi3.Parameters{pathItem.Parameters, op.Parameters} {
+				for _, parameterRef := range parameterRefs {
+					if parameterRef.Value != nil {
+						parameters[parameterRef.Value.In+" param "+parameterRef.Value.Name] = parameterRef.Value
+					}
+				}
+			}
+
+			operations[method+" "+path] = operation{Operation: op, parameters: parameters}
+		}
+	}
+
+	return operations
+}
+
+type direction int
+
+const (
+	request direction = iota
+	response
+)
+
+type comparison struct {
+	oldSpec *openapi3.T
+	newSpec *openapi3.T
+	changes []Change
+	visited map[[2]*openapi3.Schema]bool
+}
+
+func (c *comparison) add(breaking bool, rule, operation, location, message string, args ...any) {
+	c.changes = append(c.changes, Change{
+		Rule:      rule,
+		Breaking:  breaking,
+		Operation: operation,
+		Location:  location,
+		Message:   fmt.Sprintf(message, args...),
+	})
+}
+
+func (c *comparison) compareOperation(key string, oldOperation, newOperation operation) {
+	for name, oldParameter := range oldOperation.parameters {
+		newParameter, ok := newOperation.parameters[name]
+		if !ok {
+			c.add(false, "param-removed", key, name, "removed param")
+			continue
+		}
+
+		if !oldParameter.Required && newParameter.Required {
+			c.add(true, "param-required", key, name, "param became required")
+		} else if oldParameter.Required && !newParameter.Required {
+			c.add(false, "param-optional", key, name, "param became optional")
+		}
+
+		c.compareSchemas(request, key, name, oldParameter.Schema, newParameter.Schema)
+	}
+
+	for name, newParameter := range newOperation.parameters {
+		if _, ok := oldOperation.parameters[name]; ok {
+			continue
+		}
+
+		if newParameter.Required {
+			c.add(true, "param-added-required", key, name, "added required param")
+		} else {
+			c.add(false, "param-added", key, name, "added optional param")
+		}
+	}
+
+	c.compareRequestBodies(key, oldOperation.RequestBody, newOperation.RequestBody)
+	c.compareResponses(key, oldOperation.Responses, newOperation.Responses)
+}
+
+func (c *comparison) compareRequestBodies(key string, oldBodyRef, newBodyRef *openapi3.RequestBodyRef) {
+	var oldBody, newBody *openapi3.RequestBody
+	if oldBodyRef != nil {
+		oldBody = oldBodyRef.Value
+	}
+	if newBodyRef != nil {
+		newBody = newBodyRef.Value
+	}
+
+	switch {
+	case oldBody == nil && newBody == nil:
+		return
+	case oldBody == nil:
+		c.add(newBody.Required, "body-added", key, "body", "added body")
+		return
+	case newBody == nil:
+		c.add(true, "body-removed", key, "body", "removed body")
+		return
+	}
+
+	if !oldBody.Required && newBody.Required {
+		c.add(true, "body-required", key, "body", "body became required")
+	}
+
+	c.compareContent(request, key, "body", oldBody.Content, newBody.Content)
+}
+
+func (c *comparison) compareResponses(key string, oldResponses, newResponses *openapi3.Responses) {
+	oldMap, newMap := map[string]*openapi3.ResponseRef{}, map[string]*openapi3.ResponseRef{}
+	if oldResponses != nil {
+		oldMap = oldResponses.Map()
+	}
+	if newResponses != nil {
+		newMap = newResponses.Map()
+	}
+
+	for status, oldResponse := range oldMap {
+		newResponse, ok := newMap[status]
+		if !ok {
+			c.add(true, "response-status-removed", key, "response "+status, "removed response status %v, statuses are now: %v", status, strings.Join(getSortedKeys(newMap), ", "))
+			continue
+		}
+
+		if oldResponse.Value != nil && newResponse.Value != nil {
+			c.compareContent(response, key, "response "+status, oldResponse.Value.Content, newResponse.Value.Content)
+		}
+	}
+
+	for status := range newMap {
+		if _, ok := oldMap[status]; !ok {
+			c.add(false, "response-status-added", key, "response "+status, "added response status %v", status)
+		}
+	}
+}
+
+func (c *comparison) compareContent(dir direction, key, location string, oldContent, newContent openapi3.Content) {
+	for contentType, oldMediaType := range oldContent {
+		newMediaType, ok := newContent[contentType]
+		if !ok {
+			c.add(true, "content-type-removed", key, location, "removed content type %v", contentType)
+			continue
+		}
+
+		c.compareSchemas(dir, key, location, oldMediaType.Schema, newMediaType.Schema)
+	}
+
+	for contentType := range newContent {
+		if _, ok := oldContent[contentType]; !ok {
+			c.add(false, "content-type-added", key, location, "added content type %v", contentType)
+		}
+	}
+}
+
+func (c *comparison) compareSchemas(dir direction, key, location string, oldSchemaRef, newSchemaRef *openapi3.SchemaRef) {
+	oldSchemaRef, newSchemaRef = resolveSchemaRef(c.oldSpec, oldSchemaRef), resolveSchemaRef(c.newSpec, newSchemaRef)
+	if oldSchemaRef == nil || newSchemaRef == nil || oldSchemaRef.Value == nil || newSchemaRef.Value == nil {
+		if (oldSchemaRef == nil) != (newSchemaRef == nil) {
+			c.add(true, "schema-changed", key, location, "changed schema")
+		}
+		return
+	}
+
+	oldSchema, newSchema := oldSchemaRef.Value, newSchemaRef.Value
+
+	// Recursive schemas are compared once per location they are first reached from
+	pair := [2]*openapi3.Schema{oldSchema, newSchema}
+	if c.visited == nil {
+		c.visited = make(map[[2]*openapi3.Schema]bool)
+	}
+	if c.visited[pair] {
+		return
+	}
+	c.visited[pair] = true
+	defer delete(c.visited, pair)
+
+	c.compareTypes(dir, key, location, oldSchema, newSchema)
+	c.compareEnums(dir, key, location, oldSchema, newSchema)
+	c.compareBounds(dir, key, location, oldSchema, newSchema)
+
+	if oldSchema.Nullable != newSchema.Nullable {
+		// Requests break when null is no longer accepted, responses when null may now be returned
+		narrowed := oldSchema.Nullable
+		c.addNarrowing(dir, narrowed, key, location, "nullable changed from %v to %v", oldSchema.Nullable, newSchema.Nullable)
+	}
+
+	for name, compositions := range map[string][2]openapi3.SchemaRefs{
+		"oneOf": {oldSchema.OneOf, newSchema.OneOf},
+		"anyOf": {oldSchema.AnyOf, newSchema.AnyOf},
+		"allOf": {oldSchema.AllOf, newSchema.AllOf},
+	} {
+		c.compareCompositions(dir, key, location, name, compositions[0], compositions[1])
+	}
+
+	c.compareProperties(dir, key, location, oldSchema, newSchema)
+
+	if oldSchema.Items != nil || newSchema.Items != nil {
+		c.compareSchemas(dir, key, location+"[]", oldSchema.Items, newSchema.Items)
+	}
+
+	if oldSchema.AdditionalProperties.Schema != nil || newSchema.AdditionalProperties.Schema != nil {
+		c.compareSchemas(dir, key, location+"{}", oldSchema.AdditionalProperties.Schema, newSchema.AdditionalProperties.Schema)
+	}
+}
+
+// compareCompositions compares the members of a composition pairwise. Members of an allOf all describe the
+// value itself, like the $ref zeal wraps pointer fields in, so their changes are reported at its location.
+func (c *comparison) compareCompositions(dir direction, key, location, name string, oldMembers, newMembers openapi3.SchemaRefs) {
+	if len(oldMembers) != len(newMembers) {
+		c.add(true, "schema-composition-changed", key, location, "changed %v from %v to %v members", name, len(oldMembers), len(newMembers))
+		return
+	}
+
+	for i := range oldMembers {
+		memberLocation := location
+		if name != "allOf" {
+			memberLocation = fmt.Sprintf("%v.%v[%d]", location, name, i)
+		}
+
+		c.compareSchemas(dir, key, memberLocation, oldMembers[i], newMembers[i])
+	}
+}
+
+// resolveSchemaRef looks up references to component schemas which haven't been resolved, as in specs built by zeal
+func resolveSchemaRef(spec *openapi3.T, schemaRef *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if schemaRef == nil || schemaRef.Value != nil || spec == nil || spec.Components == nil {
+		return schemaRef
+	}
+
+	name, ok := strings.CutPrefix(schemaRef.Ref, "#/components/schemas/")
+	if !ok {
+		return schemaRef
+	}
+
+	if resolved, ok := spec.Components.Schemas[name]; ok {
+		return resolveSchemaRef(spec, resolved)
+	}
+
+	return schemaRef
+}
+
+// addNarrowing adds a change which narrows what is accepted when narrowed is true, or widens it otherwise
+func (c *comparison) addNarrowing(dir direction, narrowed bool, key, location, message string, args ...any) {
+	breaking := narrowed == (dir == request)
+	rule := "type-widened"
+	if narrowed {
+		rule = "type-narrowed"
+	}
+
+	c.add(breaking, rule, key, location, message, args...)
+}
+
+func (c *comparison) compareTypes(dir direction, key, location string, oldSchema, newSchema *openapi3.Schema) {
+	oldTypes, newTypes := getTypes(oldSchema), getTypes(newSchema)
+	if slices.Equal(oldTypes, newTypes) {
+		return
+	}
+
+	// A missing type accepts anything
+	narrowed := len(newTypes) > 0 && (len(oldTypes) == 0 || !isSubset(oldTypes, newTypes))
+	widened := len(oldTypes) > 0 && (len(newTypes) == 0 || !isSubset(newTypes, oldTypes))
+	message := "type changed from %v to %v"
+	if narrowed && widened {
+		c.add(true, "type-changed", key, location, message, formatTypes(oldTypes), formatTypes(newTypes))
+		return
+	}
+
+	c.addNarrowing(dir, narrowed, key, location, message, formatTypes(oldTypes), formatTypes(newTypes))
+}
+
+func getTypes(schema *openapi3.Schema) []string {
+	if schema.Type == nil {
+		return nil
+	}
+
+	types := slices.Clone(schema.Type.Slice())
+	slices.Sort(types)
+
+	return types
+}
+
+// isSubset reports whether every type in subset is accepted by superset, where a number accepts an integer
+func isSubset(subset, superset []string) bool {
+	for _, t := range subset {
+		if !slices.Contains(superset, t) && !(t == openapi3.TypeInteger && slices.Contains(superset, openapi3.TypeNumber)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatTypes(types []string) string {
+	if len(types) == 0 {
+		return "any"
+	}
+
+	return strings.Join(types, "|")
+}
+
+func (c *comparison) compareEnums(dir direction, key, location string, oldSchema, newSchema *openapi3.Schema) {
+	if len(oldSchema.Enum) == 0 && len(newSchema.Enum) == 0 {
+		return
+	}
+
+	oldValues, newValues := getEnumValues(oldSchema.Enum), getEnumValues(newSchema.Enum)
+	for _, value := range oldValues {
+		if len(newValues) > 0 && !slices.Contains(newValues, value) {
+			c.addNarrowing(dir, true, key, location, "removed enum value %v", value)
+		}
+	}
+
+	if len(oldValues) == 0 {
+		c.addNarrowing(dir, true, key, location, "added enum %v", strings.Join(newValues, ", "))
+	}
+
+	for _, value := range newValues {
+		if len(oldValues) > 0 && !slices.Contains(oldValues, value) {
+			c.addNarrowing(dir, false, key, location, "added enum value %v", value)
+		}
+	}
+
+	if len(newValues) == 0 {
+		c.addNarrowing(dir, false, key, location, "removed enum %v", strings.Join(oldValues, ", "))
+	}
+}
+
+func getEnumValues(enum []any) []string {
+	values := make([]string, 0, len(enum))
+	for _, value := range enum {
+		encoded, _ := json.Marshal(value)
+		values = append(values, string(encoded))
+	}
+
+	return values
+}
+
+func (c *comparison) compareBounds(dir direction, key, location string, oldSchema, newSchema *openapi3.Schema) {
+	compareBound(c, dir, key, location, "minimum", oldSchema.Min, newSchema.Min, true)
+	compareBound(c, dir, key, location, "maximum", oldSchema.Max, newSchema.Max, false)
+	compareBound(c, dir, key, location, "minLength", nonZero(oldSchema.MinLength), nonZero(newSchema.MinLength), true)
+	compareBound(c, dir, key, location, "maxLength", oldSchema.MaxLength, newSchema.MaxLength, false)
+	compareBound(c, dir, key, location, "minItems", nonZero(oldSchema.MinItems), nonZero(newSchema.MinItems), true)
+	compareBound(c, dir, key, location, "maxItems", oldSchema.MaxItems, newSchema.MaxItems, false)
+
+	if oldSchema.Pattern != newSchema.Pattern {
+		c.addNarrowing(dir, newSchema.Pattern != "", key, location, "pattern changed from %q to %q", oldSchema.Pattern, newSchema.Pattern)
+	}
+}
+
+func compareBound[T_Bound float64 | uint64](c *comparison, dir direction, key, location, name string, oldBound, newBound *T_Bound, isMinimum bool) {
+	switch {
+	case oldBound == nil && newBound == nil:
+		return
+	case oldBound == nil:
+		c.addNarrowing(dir, true, key, location, "added %v %v", name, *newBound)
+	case newBound == nil:
+		c.addNarrowing(dir, false, key, location, "removed %v %v", name, *oldBound)
+	case *oldBound != *newBound:
+		narrowed := (*newBound > *oldBound) == isMinimum
+		c.addNarrowing(dir, narrowed, key, location, "%v changed from %v to %v", name, *oldBound, *newBound)
+	}
+}
+
+func nonZero(value uint64) *uint64 {
+	if value == 0 {
+		return nil
+	}
+
+	return &value
+}
+
+func (c *comparison) compareProperties(dir direction, key, location string, oldSchema, newSchema *openapi3.Schema) {
+	for _, name := range getSortedKeys(oldSchema.Properties) {
+		propertyLocation := location + "." + name
+		newProperty, ok := newSchema.Properties[name]
+		if !ok {
+			// Bodies are decoded strictly, so requests which still send the property are rejected
+			c.add(true, "property-removed", key, propertyLocation, "removed property")
+			continue
+		}
+
+		wasRequired, isRequired := slices.Contains(oldSchema.Required, name), slices.Contains(newSchema.Required, name)
+		if !wasRequired && isRequired {
+			c.add(dir == request, "property-required", key, propertyLocation, "property became required")
+		} else if wasRequired && !isRequired {
+			c.add(dir == response, "property-optional", key, propertyLocation, "property became optional")
+		}
+
+		c.compareSchemas(dir, key, propertyLocation, oldSchema.Properties[name], newProperty)
+	}
+
+	for _, name := range getSortedKeys(newSchema.Properties) {
+		if _, ok := oldSchema.Properties[name]; ok {
+			continue
+		}
+
+		if dir == request && slices.Contains(newSchema.Required, name) {
+			c.add(true, "property-added-required", key, location+"."+name, "added required property")
+		} else {
+			c.add(false, "property-added", key, location+"."+name, "added property")
+		}
+	}
+}
+
+func getSortedKeys[T_Value any](values map[string]T_Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,221 @@
+package breaking
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const specTemplate = `
+openapi: 3.0.0
+info:
+  title: API
+  version: "1"
+paths:
+  /items/{id}:
+    put:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Item'
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Item'
+        "404":
+          description: Not Found
+components:
+  schemas:
+    Item:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        size:
+          type: string
+          enum: [small, large]
+        child:
+          allOf:
+            - $ref: '#/components/schemas/Child'
+    Child:
+      type: object
+      required: [id, label]
+      properties:
+        id:
+          type: integer
+        label:
+          type: string
+`
+
+func loadSpec(t *testing.T, spec string) *openapi3.T {
+	t.Helper()
+
+	loaded, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	if err != nil {
+		t.Fatalf("failed to load spec: %v\n%v", err, spec)
+	}
+
+	return loaded
+}
+
+func newSpec(t *testing.T, replacements ...string) *openapi3.T {
+	t.Helper()
+
+	spec := specTemplate
+	for i := 0; i < len(replacements); i += 2 {
+		if !strings.Contains(spec, replacements[i]) {
+			t.Fatalf("expected %q in the spec, received none", replacements[i])
+		}
+		spec = strings.Replace(spec, replacements[i], replacements[i+1], 1)
+	}
+
+	return loadSpec(t, spec)
+}
+
+func formatChanges(changes []Change) string {
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.Rule+" "+change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name         string
+		replacements []string
+		rule         string
+		location     string
+		breaking     bool
+	}{
+		{
+			name:         "removed response status",
+			replacements: []string{"        \"404\":\n          description: Not Found", ""},
+			rule:         "response-status-removed",
+			location:     "response 404",
+			breaking:     true,
+		},
+		{
+			name:         "added required param",
+			replacements: []string{"            type: integer\n", "            type: integer\n        - name: q\n          in: query\n          required: true\n          schema:\n            type: string\n"},
+			rule:         "param-added-required",
+			location:     "query param q",
+			breaking:     true,
+		},
+		{
+			name:         "added optional param",
+			replacements: []string{"            type: integer\n", "            type: integer\n        - name: q\n          in: query\n          schema:\n            type: string\n"},
+			rule:         "param-added",
+			location:     "query param q",
+			breaking:     false,
+		},
+		{
+			name:         "widened param type",
+			replacements: []string{"            type: integer\n", "            type: number\n"},
+			rule:         "type-widened",
+			location:     "path param id",
+			breaking:     false,
+		},
+		{
+			name:         "removed required property",
+			replacements: []string{"      required: [name]\n      properties:\n        name:\n          type: string\n", "      properties:\n"},
+			rule:         "property-removed",
+			location:     "body.name",
+			breaking:     true,
+		},
+		{
+			name:         "property of a composed $ref removed",
+			replacements: []string{"      required: [id, label]", "      required: [id]", "        label:\n          type: string\n", ""},
+			rule:         "property-removed",
+			location:     "response 200.child.label",
+			breaking:     true,
+		},
+		{
+			name:         "property of a composed $ref became required",
+			replacements: []string{"      required: [id, label]", "      required: [id, label, extra]", "        label:\n          type: string\n", "        label:\n          type: string\n        extra:\n          type: string\n"},
+			rule:         "property-added-required",
+			location:     "body.child.extra",
+			breaking:     true,
+		},
+		{
+			name:         "added allOf member",
+			replacements: []string{"            - $ref: '#/components/schemas/Child'\n", "            - $ref: '#/components/schemas/Child'\n            - type: object\n"},
+			rule:         "schema-composition-changed",
+			location:     "body.child",
+			breaking:     true,
+		},
+		{
+			name:         "added enum value",
+			replacements: []string{"enum: [small, large]", "enum: [small, medium, large]"},
+			rule:         "type-widened",
+			location:     "response 200.size",
+			breaking:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes := Compare(newSpec(t), newSpec(t, test.replacements...))
+
+			if !slices.ContainsFunc(changes, func(change Change) bool {
+				return change.Rule == test.rule && change.Location == test.location && change.Breaking == test.breaking
+			}) {
+				t.Fatalf("expected %v change %v at %v, received:\n%v", map[bool]string{true: "breaking", false: "non-breaking"}[test.breaking], test.rule, test.location, formatChanges(changes))
+			}
+			if !test.breaking && HasBreaking(changes) {
+				t.Fatalf("expected no breaking changes, received:\n%v", formatChanges(changes))
+			}
+		})
+	}
+}
+
+func TestCompareIdenticalSpecs(t *testing.T) {
+	if changes := Compare(newSpec(t), newSpec(t)); len(changes) > 0 {
+		t.Fatalf("expected no changes, received:\n%v", formatChanges(changes))
+	}
+}
+
+func TestCompareResolvesUnloadedRefs(t *testing.T) {
+	newItemSpec := func(childRequired ...string) *openapi3.T {
+		child := openapi3.NewObjectSchema().
+			WithProperty("id", openapi3.NewIntegerSchema()).
+			WithProperty("label", openapi3.NewStringSchema())
+		child.Required = childRequired
+		item := openapi3.NewObjectSchema().
+			WithPropertyRef("child", openapi3.NewSchemaRef("", &openapi3.Schema{AllOf: openapi3.SchemaRefs{openapi3.NewSchemaRef("#/components/schemas/Child", nil)}}))
+
+		operation := openapi3.NewOperation()
+		operation.AddResponse(200, openapi3.NewResponse().WithDescription("OK").WithJSONSchemaRef(openapi3.NewSchemaRef("#/components/schemas/Item", nil)))
+
+		spec := &openapi3.T{OpenAPI: "3.0.0", Info: &openapi3.Info{Title: "API", Version: "1"}, Paths: openapi3.NewPaths()}
+		spec.AddOperation("/items", "GET", operation)
+		spec.Components = &openapi3.Components{Schemas: openapi3.Schemas{
+			"Item":  openapi3.NewSchemaRef("", item),
+			"Child": openapi3.NewSchemaRef("", child),
+		}}
+
+		return spec
+	}
+
+	changes := Compare(newItemSpec("id", "label"), newItemSpec("id"))
+	if !slices.ContainsFunc(changes, func(change Change) bool {
+		return change.Rule == "property-optional" && change.Location == "response 200.child.label" && change.Breaking
+	}) {
+		t.Fatalf("expected a breaking property-optional change at response 200.child.label, received:\n%v", formatChanges(changes))
+	}
+}
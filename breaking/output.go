@@ -0,0 +1,105 @@
+package breaking
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+func WriteText(w io.Writer, changes []Change) error {
+	for _, change := range changes {
+		if _, err := fmt.Fprintln(w, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func WriteJSON(w io.Writer, changes []Change) error {
+	if changes == nil {
+		changes = []Change{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(changes)
+}
+
+func WriteSARIF(w io.Writer, changes []Change, specURI string) error {
+	type message struct {
+		Text string `json:"text"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+	}
+	type location struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+		} `json:"physicalLocation"`
+		LogicalLocations []struct {
+			FullyQualifiedName string `json:"fullyQualifiedName"`
+		} `json:"logicalLocations"`
+	}
+	type result struct {
+		RuleID    string     `json:"ruleId"`
+		Level     string     `json:"level"`
+		Message   message    `json:"message"`
+		Locations []location `json:"locations"`
+	}
+
+	rules := []rule{}
+	ruleIDs := make(map[string]bool)
+	results := []result{}
+	for _, change := range changes {
+		if !ruleIDs[change.Rule] {
+			ruleIDs[change.Rule] = true
+			rules = append(rules, rule{ID: change.Rule, ShortDescription: message{Text: change.Message}})
+		}
+
+		level := "note"
+		if change.Breaking {
+			level = "error"
+		}
+
+		name := change.Operation
+		if change.Location != "" {
+			name += " " + change.Location
+		}
+
+		var changeLocation location
+		changeLocation.PhysicalLocation.ArtifactLocation.URI = specURI
+		changeLocation.LogicalLocations = append(changeLocation.LogicalLocations, struct {
+			FullyQualifiedName string `json:"fullyQualifiedName"`
+		}{FullyQualifiedName: name})
+
+		results = append(results, result{
+			RuleID:    change.Rule,
+			Level:     level,
+			Message:   message{Text: name + ": " + change.Message},
+			Locations: []location{changeLocation},
+		})
+	}
+
+	log := map[string]any{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []any{map[string]any{
+			"tool": map[string]any{"driver": map[string]any{
+				"name":           "zeal",
+				"informationUri": "https://github.com/DandyCodes/zeal",
+				"rules":          rules,
+			}},
+			"results": results,
+		}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(log)
+}
@@ -0,0 +1,899 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const zealPkgPath = "github.com/DandyCodes/zeal"
+
+func genBinders(args []string) error {
+	flags := flag.NewFlagSet("gen binders", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "directory of the package to scan")
+	output := flags.String("o", "zeal_binders_gen.go", "output file, relative to the package directory")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	outputPath := *output
+	if !filepath.IsAbs(outputPath) {
+		outputPath = filepath.Join(*dir, outputPath)
+	}
+
+	outputPath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return err
+	}
+
+	pkg, err := loadPackage(*dir, outputPath)
+	if err != nil {
+		return err
+	}
+
+	generator := newBindersGenerator(pkg.Types)
+	generator.collect(pkg)
+
+	source, err := generator.generate()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath, source, 0o644)
+}
+
+func loadPackage(dir, outputPath string) (*packages.Package, error) {
+	config := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+	}
+
+	// Previously generated code may no longer compile, so it is left out of the scan
+	if file, err := parser.ParseFile(token.NewFileSet(), outputPath, nil, parser.PackageClauseOnly); err == nil {
+		config.Overlay = map[string][]byte{outputPath: []byte("package " + file.Name.Name + "\n")}
+	}
+
+	pkgs, err := packages.Load(config, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %v, received: %d", dir, len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("failed to load package %v: %v", pkg.PkgPath, pkg.Errors[0])
+	}
+
+	if pkg.PkgPath == zealPkgPath {
+		return nil, fmt.Errorf("expected package using %v, received the package itself", zealPkgPath)
+	}
+
+	return pkg, nil
+}
+
+type bindersGenerator struct {
+	pkg           *types.Package
+	imports       map[string]string
+	packageNames  map[string]string
+	importNames   map[string]bool
+	functionNames map[string]bool
+	functions     bytes.Buffer
+	registrations []string
+	params        map[string]bool
+	bodies        map[string]bool
+	responses     map[string]bool
+	encoders      map[string]string
+	decoders      map[string]string
+	runtimes      map[string]bool
+}
+
+func newBindersGenerator(pkg *types.Package) *bindersGenerator {
+	return &bindersGenerator{
+		pkg:           pkg,
+		imports:       make(map[string]string),
+		packageNames:  make(map[string]string),
+		importNames:   make(map[string]bool),
+		functionNames: make(map[string]bool),
+		params:        make(map[string]bool),
+		bodies:        make(map[string]bool),
+		responses:     make(map[string]bool),
+		encoders:      make(map[string]string),
+		decoders:      make(map[string]string),
+		runtimes:      make(map[string]bool),
+	}
+}
+
+func (g *bindersGenerator) collect(pkg *packages.Package) {
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(node ast.Node) bool {
+			structType, ok := node.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			routeDefinition, ok := pkg.TypesInfo.TypeOf(structType).(*types.Struct)
+			if !ok {
+				return true
+			}
+
+			for i := 0; i < routeDefinition.NumFields(); i++ {
+				field := routeDefinition.Field(i)
+				if !field.Embedded() {
+					continue
+				}
+
+				named, ok := types.Unalias(field.Type()).(*types.Named)
+				if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != zealPkgPath || named.TypeArgs().Len() != 1 {
+					continue
+				}
+
+				switch named.Obj().Name() {
+				case "HasParams":
+					g.addParams(named.TypeArgs().At(0))
+				case "HasBody":
+					g.addBody(named.TypeArgs().At(0))
+				case "HasResponse":
+					g.addResponse(named.TypeArgs().At(0))
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+func (g *bindersGenerator) generate() ([]byte, error) {
+	// Runtimes are added first, so their imports are named before the source is written
+	if g.runtimes["encode"] {
+		g.functions.WriteString(g.runtimeSource(encodeRuntime, encodeRuntimeImports))
+	}
+	if g.runtimes["decode"] {
+		g.functions.WriteString(g.runtimeSource(decodeRuntime, decodeRuntimeImports))
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by zeal gen binders. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %v\n\n", g.pkg.Name())
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		if isStdPath(a) != isStdPath(b) {
+			if isStdPath(a) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	if len(paths) > 0 {
+		source.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && isStdPath(paths[i-1]) != isStdPath(path) {
+				source.WriteString("\n")
+			}
+
+			if g.imports[path] == g.packageNames[path] {
+				fmt.Fprintf(&source, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(&source, "\t%v %q\n", g.imports[path], path)
+			}
+		}
+		source.WriteString(")\n\n")
+	}
+
+	if len(g.registrations) > 0 {
+		source.WriteString("func init() {\n")
+		for _, registration := range g.registrations {
+			fmt.Fprintf(&source, "\t%v\n", registration)
+		}
+		source.WriteString("}\n\n")
+	}
+
+	source.Write(g.functions.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated binders: %w", err)
+	}
+
+	return formatted, nil
+}
+
+func (g *bindersGenerator) runtimeSource(source string, imports map[string]string) string {
+	for path, name := range imports {
+		if importName := g.importName(path, name); importName != name {
+			source = regexp.MustCompile(`\b`+name+`\.`).ReplaceAllString(source, importName+".")
+		}
+	}
+
+	return source
+}
+
+func isStdPath(path string) bool {
+	firstElement, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(firstElement, ".")
+}
+
+func (g *bindersGenerator) addParams(paramsType types.Type) {
+	paramsType = types.Unalias(paramsType)
+	key := types.TypeString(paramsType, nil)
+	if g.params[key] {
+		return
+	}
+	g.params[key] = true
+
+	paramsStruct, ok := paramsType.Underlying().(*types.Struct)
+	if !ok || !g.isNameable(paramsType) {
+		return
+	}
+
+	var fields []*types.Var
+	for i := 0; i < paramsStruct.NumFields(); i++ {
+		field := paramsStruct.Field(i)
+		if !field.Exported() {
+			continue
+		}
+
+		if !isParamKind(field.Type()) || !g.isNameable(field.Type()) {
+			return
+		}
+
+		fields = append(fields, field)
+	}
+
+	name := g.functionName("zealBindParams", "")
+	http := g.importName("net/http", "http")
+	zeal := g.importName(zealPkgPath, "zeal")
+	g.registrations = append(g.registrations, fmt.Sprintf("%v.RegisterParamsBinder(%v)", zeal, name))
+
+	function := &g.functions
+	fmt.Fprintf(function, "func %v(r *%v.Request, params *%v) error {\n", name, http, g.typeString(paramsType))
+	if len(fields) == 0 {
+		function.WriteString("return nil\n}\n\n")
+		return
+	}
+
+	fmt.Fprintf(function, "var query %v.Values\nvar err error\nvar rawValue string\n\n", g.importName("net/url", "url"))
+	for _, field := range fields {
+		fmt.Fprintf(function, "rawValue = r.PathValue(%[1]q)\nif rawValue == \"\" {\nif query == nil {\nquery = r.URL.Query()\n}\nrawValue = query.Get(%[1]q)\n}\n", field.Name())
+		g.writeParamParser(field)
+		function.WriteString("\n")
+	}
+	function.WriteString("return err\n}\n\n")
+}
+
+func isParamKind(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return false
+	}
+
+	switch basic.Kind() {
+	case types.Bool, types.String,
+		types.Int, types.Int8, types.Int16, types.Int32, types.Int64,
+		types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64,
+		types.Float32, types.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *bindersGenerator) writeParamParser(field *types.Var) {
+	function := &g.functions
+	fieldType := g.typeString(field.Type())
+	basic := field.Type().Underlying().(*types.Basic)
+	if basic.Kind() == types.String {
+		fmt.Fprintf(function, "params.%v = %v(rawValue)\n", field.Name(), fieldType)
+		return
+	}
+
+	fmtName := g.importName("fmt", "fmt")
+	strconvName := g.importName("strconv", "strconv")
+
+	switch basic.Kind() {
+	case types.Bool:
+		fmt.Fprintf(function, "if val, parseErr := %v.ParseBool(rawValue); parseErr != nil {\nerr = %v.Errorf(\"failed to parse boolean from: %%v\", rawValue)\n} else {\nparams.%v = %v(val)\n}\n", strconvName, fmtName, field.Name(), fieldType)
+	case types.Int, types.Int8, types.Int16, types.Int32, types.Int64:
+		fmt.Fprintf(function, "if val, parseErr := %v.ParseInt(rawValue, 10, 64); parseErr != nil {\nerr = %v.Errorf(\"failed to parse integer from: %%v\", rawValue)\n", strconvName, fmtName)
+		if basic.Kind() != types.Int64 {
+			limit := g.importName("math", "math") + "." + kindLimitName(basic)
+			fmt.Fprintf(function, "} else if val < %v || val > %v {\nerr = %v.Errorf(\"value out of range for %v: %%d\", val)\n", strings.Replace(limit, "Max", "Min", 1), limit, fmtName, basic.Name())
+		}
+		fmt.Fprintf(function, "} else {\nparams.%v = %v(val)\n}\n", field.Name(), fieldType)
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		fmt.Fprintf(function, "if val, parseErr := %v.ParseUint(rawValue, 10, 64); parseErr != nil {\nerr = %v.Errorf(\"failed to parse unsigned integer from: %%v\", rawValue)\n", strconvName, fmtName)
+		if basic.Kind() != types.Uint64 {
+			limit := g.importName("math", "math") + "." + kindLimitName(basic)
+			fmt.Fprintf(function, "} else if val > %v {\nerr = %v.Errorf(\"value out of range for %v: %%d\", val)\n", limit, fmtName, basic.Name())
+		}
+		fmt.Fprintf(function, "} else {\nparams.%v = %v(val)\n}\n", field.Name(), fieldType)
+	case types.Float32:
+		mathName := g.importName("math", "math")
+		fmt.Fprintf(function, "if val, parseErr := %v.ParseFloat(rawValue, 64); parseErr != nil {\nerr = %v.Errorf(\"failed to parse float from: %%v\", rawValue)\n", strconvName, fmtName)
+		fmt.Fprintf(function, "} else if !(val >= -%[1]v.MaxFloat32 && val <= %[1]v.MaxFloat32) {\nerr = %[2]v.Errorf(\"value out of range for float32: %%v\", val)\n", mathName, fmtName)
+		fmt.Fprintf(function, "} else {\nparams.%v = %v(val)\n}\n", field.Name(), fieldType)
+	case types.Float64:
+		fmt.Fprintf(function, "if val, parseErr := %v.ParseFloat(rawValue, 64); parseErr != nil {\nerr = %v.Errorf(\"failed to parse float from: %%v\", rawValue)\n", strconvName, fmtName)
+		fmt.Fprintf(function, "} else {\nparams.%v = %v(val)\n}\n", field.Name(), fieldType)
+	}
+}
+
+func kindLimitName(basic *types.Basic) string {
+	name := basic.Name()
+	return "Max" + strings.ToUpper(name[:1]) + name[1:]
+}
+
+func (g *bindersGenerator) addResponse(responseType types.Type) {
+	responseType = types.Unalias(responseType)
+	key := types.TypeString(responseType, nil)
+	if g.responses[key] {
+		return
+	}
+	g.responses[key] = true
+
+	if !g.isSupported(responseType, marshalerMethods, make(map[string]bool)) {
+		return
+	}
+
+	name := g.encoder(responseType)
+	zeal := g.importName(zealPkgPath, "zeal")
+	g.registrations = append(g.registrations, fmt.Sprintf("%v.RegisterResponseEncoder(%v)", zeal, name))
+}
+
+var (
+	marshalerMethods   = []string{"MarshalJSON", "MarshalText"}
+	unmarshalerMethods = []string{"UnmarshalJSON", "UnmarshalText"}
+)
+
+// isSupported reports whether generated code handles the type exactly like encoding/json,
+// which it can't if the type or its contents customize their JSON with one of the methods
+func (g *bindersGenerator) isSupported(t types.Type, methods []string, visiting map[string]bool) bool {
+	t = types.Unalias(t)
+	key := types.TypeString(t, nil)
+	if visiting[key] {
+		return true
+	}
+	visiting[key] = true
+
+	if !g.isNameable(t) || hasMethod(t, methods) {
+		return false
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return u.Info()&(types.IsBoolean|types.IsInteger|types.IsFloat|types.IsString) != 0 && u.Info()&types.IsUntyped == 0
+	case *types.Pointer:
+		return g.isSupported(u.Elem(), methods, visiting)
+	case *types.Slice:
+		if isByte(u.Elem()) {
+			return true
+		}
+
+		if basic, ok := u.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Uint8 {
+			return false
+		}
+
+		return g.isSupported(u.Elem(), methods, visiting)
+	case *types.Array:
+		return g.isSupported(u.Elem(), methods, visiting)
+	case *types.Map:
+		key, ok := u.Key().Underlying().(*types.Basic)
+		if !ok || key.Kind() != types.String || hasMethod(u.Key(), methods) {
+			return false
+		}
+
+		return g.isNameable(u.Key()) && g.isSupported(u.Elem(), methods, visiting)
+	case *types.Struct:
+		names := make(map[string]bool)
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if field.Embedded() {
+				return false
+			}
+
+			name, _, options, skip := getJSONField(field, u.Tag(i))
+			if skip {
+				continue
+			}
+
+			if names[name] || slices.Contains(options, "string") {
+				return false
+			}
+			names[name] = true
+
+			if !g.isSupported(field.Type(), methods, visiting) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+func hasMethod(t types.Type, methods []string) bool {
+	for _, method := range methods {
+		obj, _, _ := types.LookupFieldOrMethod(t, true, nil, method)
+		if _, ok := obj.(*types.Func); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isByte(t types.Type) bool {
+	basic, ok := types.Unalias(t).(*types.Basic)
+	return ok && basic.Kind() == types.Uint8
+}
+
+func getJSONField(field *types.Var, tag string) (name string, omitEmpty bool, options []string, skip bool) {
+	if !field.Exported() {
+		return "", false, nil, true
+	}
+
+	jsonTag := reflect.StructTag(tag).Get("json")
+	if jsonTag == "-" {
+		return "", false, nil, true
+	}
+
+	name, rawOptions, _ := strings.Cut(jsonTag, ",")
+	if !isValidJSONTag(name) {
+		name = ""
+	}
+
+	if name == "" {
+		name = field.Name()
+	}
+
+	if rawOptions != "" {
+		options = strings.Split(rawOptions, ",")
+	}
+
+	return name, slices.Contains(options, "omitempty"), options, false
+}
+
+func isValidJSONTag(tag string) bool {
+	if tag == "" {
+		return false
+	}
+
+	for _, c := range tag {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g *bindersGenerator) encoder(t types.Type) string {
+	t = types.Unalias(t)
+	key := types.TypeString(t, nil)
+	if name, ok := g.encoders[key]; ok {
+		return name
+	}
+
+	name := g.functionName("zealAppendJSON", g.encoderSuffix(t))
+	g.encoders[key] = name
+
+	var function bytes.Buffer
+	fmt.Fprintf(&function, "func %v(dst []byte, v *%v) ([]byte, error) {\n", name, g.typeString(t))
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		g.writeBasicEncoder(&function, u)
+	case *types.Pointer:
+		fmt.Fprintf(&function, "if *v == nil {\nreturn append(dst, \"null\"...), nil\n}\n\nreturn %v(dst, *v)\n", g.encoder(u.Elem()))
+	case *types.Slice:
+		function.WriteString("if *v == nil {\nreturn append(dst, \"null\"...), nil\n}\n\n")
+		if isByte(u.Elem()) {
+			fmt.Fprintf(&function, "dst = append(dst, '\"')\ndst = %v.StdEncoding.AppendEncode(dst, *v)\n\nreturn append(dst, '\"'), nil\n", g.importName("encoding/base64", "base64"))
+			break
+		}
+		g.writeElementsEncoder(&function, u.Elem())
+	case *types.Array:
+		g.writeElementsEncoder(&function, u.Elem())
+	case *types.Map:
+		g.writeMapEncoder(&function, u)
+	case *types.Struct:
+		g.writeStructEncoder(&function, u)
+	}
+
+	function.WriteString("}\n\n")
+	g.functions.Write(function.Bytes())
+
+	return name
+}
+
+func (g *bindersGenerator) writeBasicEncoder(function *bytes.Buffer, basic *types.Basic) {
+	switch {
+	case basic.Info()&types.IsBoolean != 0:
+		function.WriteString("if *v {\nreturn append(dst, \"true\"...), nil\n}\n\nreturn append(dst, \"false\"...), nil\n")
+	case basic.Info()&types.IsUnsigned != 0:
+		fmt.Fprintf(function, "return %v.AppendUint(dst, uint64(*v), 10), nil\n", g.importName("strconv", "strconv"))
+	case basic.Info()&types.IsInteger != 0:
+		fmt.Fprintf(function, "return %v.AppendInt(dst, int64(*v), 10), nil\n", g.importName("strconv", "strconv"))
+	case basic.Kind() == types.Float32:
+		g.runtimes["encode"] = true
+		function.WriteString("return zealJSONAppendFloat(dst, float64(*v), 32)\n")
+	case basic.Info()&types.IsFloat != 0:
+		g.runtimes["encode"] = true
+		function.WriteString("return zealJSONAppendFloat(dst, float64(*v), 64)\n")
+	case basic.Info()&types.IsString != 0:
+		g.runtimes["encode"] = true
+		function.WriteString("return zealJSONAppendString(dst, string(*v)), nil\n")
+	}
+}
+
+func (g *bindersGenerator) writeElementsEncoder(function *bytes.Buffer, elem types.Type) {
+	fmt.Fprintf(function, "dst = append(dst, '[')\nfor i := range *v {\nif i > 0 {\ndst = append(dst, ',')\n}\n\nvar err error\nif dst, err = %v(dst, &(*v)[i]); err != nil {\nreturn dst, err\n}\n}\n\nreturn append(dst, ']'), nil\n", g.encoder(elem))
+}
+
+func (g *bindersGenerator) writeMapEncoder(function *bytes.Buffer, m *types.Map) {
+	function.WriteString("if *v == nil {\nreturn append(dst, \"null\"...), nil\n}\n\n")
+	fmt.Fprintf(function, "keys := make([]string, 0, len(*v))\nfor key := range *v {\nkeys = append(keys, string(key))\n}\n%v.Sort(keys)\n\n", g.importName("slices", "slices"))
+	g.runtimes["encode"] = true
+	function.WriteString("dst = append(dst, '{')\nfor i, key := range keys {\nif i > 0 {\ndst = append(dst, ',')\n}\n\ndst = zealJSONAppendString(dst, key)\ndst = append(dst, ':')\n")
+	fmt.Fprintf(function, "value := (*v)[%v(key)]\nvar err error\nif dst, err = %v(dst, &value); err != nil {\nreturn dst, err\n}\n}\n\nreturn append(dst, '}'), nil\n", g.typeString(m.Key()), g.encoder(m.Elem()))
+}
+
+func (g *bindersGenerator) writeStructEncoder(function *bytes.Buffer, s *types.Struct) {
+	type jsonField struct {
+		field     *types.Var
+		name      string
+		omitEmpty bool
+	}
+
+	var fields []jsonField
+	hasOmitEmpty := false
+	for i := 0; i < s.NumFields(); i++ {
+		name, omitEmpty, _, skip := getJSONField(s.Field(i), s.Tag(i))
+		if skip {
+			continue
+		}
+
+		fields = append(fields, jsonField{field: s.Field(i), name: name, omitEmpty: omitEmpty})
+		hasOmitEmpty = hasOmitEmpty || omitEmpty
+	}
+
+	function.WriteString("dst = append(dst, '{')\n")
+	if len(fields) > 0 {
+		function.WriteString("var err error\n")
+	}
+	if hasOmitEmpty {
+		function.WriteString("comma := false\n")
+	}
+	function.WriteString("\n")
+
+	for i, field := range fields {
+		condition, isConditional := "", false
+		if field.omitEmpty {
+			condition, isConditional = getNonEmptyCondition("v."+field.field.Name(), field.field.Type())
+		}
+
+		if isConditional {
+			fmt.Fprintf(function, "if %v {\n", condition)
+		}
+
+		if hasOmitEmpty {
+			function.WriteString("if comma {\ndst = append(dst, ',')\n}\ncomma = true\n")
+		} else if i > 0 {
+			function.WriteString("dst = append(dst, ',')\n")
+		}
+
+		key, _ := json.Marshal(field.name)
+		fmt.Fprintf(function, "dst = append(dst, %v...)\n", strconv.Quote(string(key)+":"))
+		fmt.Fprintf(function, "if dst, err = %v(dst, &v.%v); err != nil {\nreturn dst, err\n}\n", g.encoder(field.field.Type()), field.field.Name())
+
+		if isConditional {
+			function.WriteString("}\n")
+		}
+	}
+
+	function.WriteString("\nreturn append(dst, '}'), nil\n")
+}
+
+func getNonEmptyCondition(value string, t types.Type) (string, bool) {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return value, true
+		case u.Info()&types.IsString != 0:
+			return value + ` != ""`, true
+		default:
+			return value + " != 0", true
+		}
+	case *types.Pointer:
+		return value + " != nil", true
+	case *types.Slice, *types.Map, *types.Array:
+		return "len(" + value + ") != 0", true
+	default:
+		return "", false
+	}
+}
+
+func (g *bindersGenerator) addBody(bodyType types.Type) {
+	bodyType = types.Unalias(bodyType)
+	key := types.TypeString(bodyType, nil)
+	if g.bodies[key] {
+		return
+	}
+	g.bodies[key] = true
+
+	if !g.isSupported(bodyType, unmarshalerMethods, make(map[string]bool)) {
+		return
+	}
+
+	name := g.decoder(bodyType)
+	zeal := g.importName(zealPkgPath, "zeal")
+	g.runtimes["decode"] = true
+	g.registrations = append(g.registrations, fmt.Sprintf("%v.RegisterBodyDecoder(func(data []byte, body *%v) error {\nreturn zealJSONDecodeBody(data, body, %v)\n})", zeal, g.typeString(bodyType), name))
+}
+
+func (g *bindersGenerator) decoder(t types.Type) string {
+	t = types.Unalias(t)
+	key := types.TypeString(t, nil)
+	if name, ok := g.decoders[key]; ok {
+		return name
+	}
+
+	name := g.functionName("zealDecodeJSON", g.encoderSuffix(t))
+	g.decoders[key] = name
+
+	var function bytes.Buffer
+	fmt.Fprintf(&function, "func %v(d *zealJSONDecoder, v *%v) error {\n", name, g.typeString(t))
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		g.writeBasicDecoder(&function, t, u)
+	case *types.Pointer:
+		fmt.Fprintf(&function, "if d.isNull() {\n*v = nil\nreturn nil\n}\n\nif *v == nil {\n*v = new(%v)\n}\n\nreturn %v(d, *v)\n", g.typeString(u.Elem()), g.decoder(u.Elem()))
+	case *types.Slice:
+		g.writeSliceDecoder(&function, t, u)
+	case *types.Array:
+		g.writeArrayDecoder(&function, u)
+	case *types.Map:
+		g.writeMapDecoder(&function, t, u)
+	case *types.Struct:
+		g.writeStructDecoder(&function, u)
+	}
+
+	function.WriteString("}\n\n")
+	g.functions.Write(function.Bytes())
+
+	return name
+}
+
+func (g *bindersGenerator) writeBasicDecoder(function *bytes.Buffer, t types.Type, basic *types.Basic) {
+	// Like encoding/json, null leaves values unchanged
+	function.WriteString("if d.isNull() {\nreturn nil\n}\n\n")
+
+	switch {
+	case basic.Info()&types.IsBoolean != 0:
+		function.WriteString("val, err := d.readBool()\n")
+	case basic.Info()&types.IsString != 0:
+		function.WriteString("val, err := d.readString()\n")
+	default:
+		function.WriteString("number, err := d.readNumber()\nif err != nil {\nreturn err\n}\n\n")
+		strconvName := g.importName("strconv", "strconv")
+		switch {
+		case basic.Info()&types.IsUnsigned != 0:
+			fmt.Fprintf(function, "val, err := %v.ParseUint(number, 10, %v)\n", strconvName, getBitSize(basic))
+		case basic.Info()&types.IsInteger != 0:
+			fmt.Fprintf(function, "val, err := %v.ParseInt(number, 10, %v)\n", strconvName, getBitSize(basic))
+		default:
+			fmt.Fprintf(function, "val, err := %v.ParseFloat(number, %v)\n", strconvName, getBitSize(basic))
+		}
+	}
+
+	fmt.Fprintf(function, "if err != nil {\nreturn err\n}\n\n*v = %v(val)\n\nreturn nil\n", g.typeString(t))
+}
+
+func getBitSize(basic *types.Basic) int {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+	case types.Int64, types.Uint64, types.Float64:
+		return 64
+	default:
+		return 0
+	}
+}
+
+func (g *bindersGenerator) writeSliceDecoder(function *bytes.Buffer, t types.Type, slice *types.Slice) {
+	function.WriteString("if d.isNull() {\n*v = nil\nreturn nil\n}\n\n")
+
+	if isByte(slice.Elem()) {
+		base64Name := g.importName("encoding/base64", "base64")
+		function.WriteString("if d.peek() == '\"' {\nencoded, err := d.readString()\nif err != nil {\nreturn err\n}\n\n")
+		fmt.Fprintf(function, "decoded := make([]byte, %[1]v.StdEncoding.DecodedLen(len(encoded)))\nn, err := %[1]v.StdEncoding.Decode(decoded, []byte(encoded))\nif err != nil {\nreturn err\n}\n\n*v = decoded[:n]\n\nreturn nil\n}\n\n", base64Name)
+	}
+
+	// Elements are decoded into the slice's existing elements, growing it as needed, like encoding/json
+	function.WriteString("if !d.consume('[') {\nreturn d.invalid()\n}\n\ni := 0\n")
+	function.WriteString("for first := true; ; first = false {\nmore, err := d.next(']', first)\nif err != nil {\nreturn err\n}\nif !more {\nbreak\n}\n\n")
+	fmt.Fprintf(function, "if i >= cap(*v) {\n*v = %v.Grow(*v, 1)\n}\nif i >= len(*v) {\n*v = (*v)[:i+1]\n}\n", g.importName("slices", "slices"))
+	fmt.Fprintf(function, "if err := %v(d, &(*v)[i]); err != nil {\nreturn err\n}\ni++\n}\n\n", g.decoder(slice.Elem()))
+	fmt.Fprintf(function, "if i < len(*v) {\n*v = (*v)[:i]\n}\nif i == 0 {\n*v = make(%v, 0)\n}\n\nreturn nil\n", g.typeString(t))
+}
+
+func (g *bindersGenerator) writeArrayDecoder(function *bytes.Buffer, array *types.Array) {
+	function.WriteString("if d.isNull() {\nreturn nil\n}\n\nif !d.consume('[') {\nreturn d.invalid()\n}\n\ni := 0\n")
+	function.WriteString("for first := true; ; first = false {\nmore, err := d.next(']', first)\nif err != nil {\nreturn err\n}\nif !more {\nbreak\n}\n\n")
+	fmt.Fprintf(function, "if i < len(*v) {\nerr = %v(d, &(*v)[i])\n} else {\nerr = d.skipValue()\n}\nif err != nil {\nreturn err\n}\ni++\n}\n\n", g.decoder(array.Elem()))
+	function.WriteString("if i < len(*v) {\nclear((*v)[i:])\n}\n\nreturn nil\n")
+}
+
+func (g *bindersGenerator) writeMapDecoder(function *bytes.Buffer, t types.Type, m *types.Map) {
+	function.WriteString("if d.isNull() {\n*v = nil\nreturn nil\n}\n\nif !d.consume('{') {\nreturn d.invalid()\n}\n\n")
+	fmt.Fprintf(function, "if *v == nil {\n*v = make(%v)\n}\n\n", g.typeString(t))
+	function.WriteString("for first := true; ; first = false {\nmore, err := d.next('}', first)\nif err != nil || !more {\nreturn err\n}\n\nkey, err := d.key()\nif err != nil {\nreturn err\n}\n\n")
+	fmt.Fprintf(function, "var value %v\nif err := %v(d, &value); err != nil {\nreturn err\n}\n(*v)[%v(key)] = value\n}\n", g.typeString(m.Elem()), g.decoder(m.Elem()), g.typeString(m.Key()))
+}
+
+func (g *bindersGenerator) writeStructDecoder(function *bytes.Buffer, s *types.Struct) {
+	type jsonField struct {
+		field *types.Var
+		name  string
+	}
+
+	var fields []jsonField
+	for i := 0; i < s.NumFields(); i++ {
+		name, _, _, skip := getJSONField(s.Field(i), s.Tag(i))
+		if !skip {
+			fields = append(fields, jsonField{field: s.Field(i), name: name})
+		}
+	}
+
+	function.WriteString("if d.isNull() {\nreturn nil\n}\n\nif !d.consume('{') {\nreturn d.invalid()\n}\n\n")
+	function.WriteString("for first := true; ; first = false {\nmore, err := d.next('}', first)\nif err != nil || !more {\nreturn err\n}\n\nkey, err := d.key()\nif err != nil {\nreturn err\n}\n\nswitch {\n")
+
+	// Keys match names exactly, or else the first name which is equal ignoring case, like encoding/json
+	for _, field := range fields {
+		fmt.Fprintf(function, "case key == %v:\nerr = %v(d, &v.%v)\n", strconv.Quote(field.name), g.decoder(field.field.Type()), field.field.Name())
+	}
+	for _, field := range fields {
+		fmt.Fprintf(function, "case %v.EqualFold(key, %v):\nerr = %v(d, &v.%v)\n", g.importName("strings", "strings"), strconv.Quote(field.name), g.decoder(field.field.Type()), field.field.Name())
+	}
+
+	function.WriteString("default:\nerr = d.unknownField(key)\n}\nif err != nil {\nreturn err\n}\n}\n")
+}
+
+func (g *bindersGenerator) encoderSuffix(t types.Type) string {
+	switch t := t.(type) {
+	case *types.Basic:
+		return exportName(t.Name())
+	case *types.Named:
+		if t.Obj().Pkg() == g.pkg || t.Obj().Pkg() == nil {
+			return t.Obj().Name()
+		}
+
+		return exportName(t.Obj().Pkg().Name()) + t.Obj().Name()
+	default:
+		return ""
+	}
+}
+
+func exportName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func (g *bindersGenerator) functionName(prefix, suffix string) string {
+	name := prefix + suffix
+	for i := 0; suffix == "" || g.functionNames[name]; i++ {
+		name = prefix + suffix + strconv.Itoa(i)
+		if !g.functionNames[name] {
+			break
+		}
+	}
+	g.functionNames[name] = true
+
+	return name
+}
+
+func (g *bindersGenerator) isNameable(t types.Type) bool {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		return t.Kind() != types.UnsafePointer && t.Kind() != types.Invalid && t.Info()&types.IsUntyped == 0
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			return true
+		}
+
+		if obj.Parent() != obj.Pkg().Scope() || obj.Pkg() != g.pkg && !obj.Exported() {
+			return false
+		}
+
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if !g.isNameable(t.TypeArgs().At(i)) {
+				return false
+			}
+		}
+
+		return true
+	case *types.Pointer:
+		return g.isNameable(t.Elem())
+	case *types.Slice:
+		return g.isNameable(t.Elem())
+	case *types.Array:
+		return g.isNameable(t.Elem())
+	case *types.Map:
+		return g.isNameable(t.Key()) && g.isNameable(t.Elem())
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			field := t.Field(i)
+			if !field.Exported() && field.Pkg() != g.pkg || !g.isNameable(field.Type()) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *bindersGenerator) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == g.pkg {
+			return ""
+		}
+
+		return g.importName(pkg.Path(), pkg.Name())
+	})
+}
+
+func (g *bindersGenerator) importName(path, name string) string {
+	if importName, ok := g.imports[path]; ok {
+		return importName
+	}
+
+	importName := name
+	for i := 2; g.importNames[importName]; i++ {
+		importName = name + strconv.Itoa(i)
+	}
+
+	g.imports[path] = importName
+	g.packageNames[path] = name
+	g.importNames[importName] = true
+
+	return importName
+}
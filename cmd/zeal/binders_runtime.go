@@ -0,0 +1,385 @@
+package main
+
+// The runtimes are written into generated files which use them, so generated code depends only on
+// the registration functions of zeal. Their package names are replaced if they collide with other imports.
+
+var encodeRuntimeImports = map[string]string{
+	"encoding/json": "json",
+	"fmt":           "fmt",
+	"math":          "math",
+	"strconv":       "strconv",
+	"unicode/utf8":  "utf8",
+}
+
+const encodeRuntime = `
+func zealJSONAppendString(dst []byte, value string) []byte {
+	const hex = "0123456789abcdef"
+
+	// Invalid UTF-8 is rare, and its replacement differs between Go versions
+	if !utf8.ValidString(value) {
+		encoded, _ := json.Marshal(value)
+		return append(dst, encoded...)
+	}
+
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(value); {
+		if b := value[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+
+			dst = append(dst, value[start:i]...)
+			switch b {
+			case '\\', '"':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		c, size := utf8.DecodeRuneInString(value[i:])
+		if c == '\u2028' || c == '\u2029' {
+			dst = append(dst, value[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+
+		i += size
+	}
+	dst = append(dst, value[start:]...)
+
+	return append(dst, '"')
+}
+
+func zealJSONAppendFloat(dst []byte, value float64, bitSize int) ([]byte, error) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return dst, fmt.Errorf("unsupported JSON value: %v", strconv.FormatFloat(value, 'g', -1, bitSize))
+	}
+
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) || bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+
+	dst = strconv.AppendFloat(dst, value, format, -1, bitSize)
+	if format == 'e' {
+		// Clean up e-09 to e-9
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+
+	return dst, nil
+}
+`
+
+var decodeRuntimeImports = map[string]string{
+	"encoding/json": "json",
+	"fmt":           "fmt",
+	"io":            "io",
+	"unicode/utf8":  "utf8",
+}
+
+const decodeRuntime = `
+// zealJSONDecoder decodes the first JSON value of a body strictly, like a json.Decoder with DisallowUnknownFields
+type zealJSONDecoder struct {
+	data []byte
+	pos  int
+}
+
+func zealJSONDecodeBody[T any](data []byte, body *T, decode func(*zealJSONDecoder, *T) error) error {
+	d := &zealJSONDecoder{data: data}
+	d.skipSpace()
+	if d.pos == len(d.data) {
+		return io.EOF
+	}
+
+	return decode(d, body)
+}
+
+func (d *zealJSONDecoder) skipSpace() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (d *zealJSONDecoder) invalid() error {
+	if d.pos >= len(d.data) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("unexpected JSON at offset %d: %q", d.pos, d.data[d.pos])
+}
+
+func (d *zealJSONDecoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+
+	return 0
+}
+
+func (d *zealJSONDecoder) consume(c byte) bool {
+	d.skipSpace()
+	if d.pos < len(d.data) && d.data[d.pos] == c {
+		d.pos++
+		return true
+	}
+
+	return false
+}
+
+func (d *zealJSONDecoder) literal(value string) bool {
+	d.skipSpace()
+	if len(d.data)-d.pos >= len(value) && string(d.data[d.pos:d.pos+len(value)]) == value {
+		d.pos += len(value)
+		return true
+	}
+
+	return false
+}
+
+func (d *zealJSONDecoder) isNull() bool {
+	return d.literal("null")
+}
+
+// next consumes the separator before the next element of an object or array, and reports whether there is one
+func (d *zealJSONDecoder) next(end byte, first bool) (bool, error) {
+	if first {
+		return !d.consume(end), nil
+	}
+
+	if d.consume(end) {
+		return false, nil
+	}
+
+	if d.consume(',') {
+		return true, nil
+	}
+
+	return false, d.invalid()
+}
+
+func (d *zealJSONDecoder) key() (string, error) {
+	key, err := d.readString()
+	if err != nil {
+		return "", err
+	}
+
+	if !d.consume(':') {
+		return "", d.invalid()
+	}
+
+	return key, nil
+}
+
+func (d *zealJSONDecoder) readBool() (bool, error) {
+	switch {
+	case d.literal("true"):
+		return true, nil
+	case d.literal("false"):
+		return false, nil
+	default:
+		return false, d.invalid()
+	}
+}
+
+func (d *zealJSONDecoder) readString() (string, error) {
+	raw, isPlain, err := d.readRawString()
+	if err != nil {
+		return "", err
+	}
+
+	if isPlain {
+		return string(raw[1 : len(raw)-1]), nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// readRawString returns a quoted string, and whether its contents are valid UTF-8 without escapes
+func (d *zealJSONDecoder) readRawString() ([]byte, bool, error) {
+	if !d.consume('"') {
+		return nil, false, d.invalid()
+	}
+
+	start, isPlain := d.pos-1, true
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		switch {
+		case c == '"':
+			d.pos++
+			raw := d.data[start:d.pos]
+			return raw, isPlain && utf8.Valid(raw), nil
+		case c < 0x20:
+			return nil, false, d.invalid()
+		case c == '\\':
+			isPlain = false
+			d.pos++
+			if d.pos >= len(d.data) {
+				return nil, false, d.invalid()
+			}
+
+			switch d.data[d.pos] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				d.pos++
+			case 'u':
+				d.pos++
+				for i := 0; i < 4; i++ {
+					if d.pos >= len(d.data) || !zealJSONIsHex(d.data[d.pos]) {
+						return nil, false, d.invalid()
+					}
+					d.pos++
+				}
+			default:
+				return nil, false, d.invalid()
+			}
+		default:
+			d.pos++
+		}
+	}
+
+	return nil, false, d.invalid()
+}
+
+func zealJSONIsHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func (d *zealJSONDecoder) readNumber() (string, error) {
+	d.skipSpace()
+	start := d.pos
+
+	if d.pos < len(d.data) && d.data[d.pos] == '-' {
+		d.pos++
+	}
+
+	switch {
+	case d.pos < len(d.data) && d.data[d.pos] == '0':
+		d.pos++
+	case d.pos < len(d.data) && '1' <= d.data[d.pos] && d.data[d.pos] <= '9':
+		d.skipDigits()
+	default:
+		return "", d.invalid()
+	}
+
+	if d.pos < len(d.data) && d.data[d.pos] == '.' {
+		d.pos++
+		if !d.skipDigits() {
+			return "", d.invalid()
+		}
+	}
+
+	if d.pos < len(d.data) && (d.data[d.pos] == 'e' || d.data[d.pos] == 'E') {
+		d.pos++
+		if d.pos < len(d.data) && (d.data[d.pos] == '+' || d.data[d.pos] == '-') {
+			d.pos++
+		}
+		if !d.skipDigits() {
+			return "", d.invalid()
+		}
+	}
+
+	return string(d.data[start:d.pos]), nil
+}
+
+func (d *zealJSONDecoder) skipDigits() bool {
+	start := d.pos
+	for d.pos < len(d.data) && '0' <= d.data[d.pos] && d.data[d.pos] <= '9' {
+		d.pos++
+	}
+
+	return d.pos > start
+}
+
+// skipValue checks the syntax of a value which isn't decoded, like the elements past the end of an array
+func (d *zealJSONDecoder) skipValue() error {
+	d.skipSpace()
+	if d.pos >= len(d.data) {
+		return d.invalid()
+	}
+
+	switch d.data[d.pos] {
+	case '{':
+		d.pos++
+		for first := true; ; first = false {
+			more, err := d.next('}', first)
+			if err != nil || !more {
+				return err
+			}
+
+			if _, _, err := d.readRawString(); err != nil {
+				return err
+			}
+			if !d.consume(':') {
+				return d.invalid()
+			}
+			if err := d.skipValue(); err != nil {
+				return err
+			}
+		}
+	case '[':
+		d.pos++
+		for first := true; ; first = false {
+			more, err := d.next(']', first)
+			if err != nil || !more {
+				return err
+			}
+
+			if err := d.skipValue(); err != nil {
+				return err
+			}
+		}
+	case '"':
+		_, _, err := d.readRawString()
+		return err
+	case 't', 'f':
+		_, err := d.readBool()
+		return err
+	case 'n':
+		if !d.isNull() {
+			return d.invalid()
+		}
+		return nil
+	default:
+		_, err := d.readNumber()
+		return err
+	}
+}
+
+func (d *zealJSONDecoder) unknownField(key string) error {
+	return fmt.Errorf("json: unknown field %q", key)
+}
+`
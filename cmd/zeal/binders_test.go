@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedBindersAreCurrent(t *testing.T) {
+	dir := filepath.Join("..", "..", "internal", "conformance")
+	outputPath, err := filepath.Abs(filepath.Join(dir, "zeal_binders_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := loadPackage(dir, outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generator := newBindersGenerator(pkg.Types)
+	generator.collect(pkg)
+
+	source, err := generator.generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	committed, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(source, committed) {
+		t.Fatalf("expected %v to match the generator, run go generate in %v", outputPath, dir)
+	}
+}
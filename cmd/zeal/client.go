@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DandyCodes/zeal"
+)
+
+func genClient(args []string) error {
+	flags := flag.NewFlagSet("gen client", flag.ContinueOnError)
+	source := addSourceFlags(flags)
+	lang := flags.String("lang", "", "client language, go or ts")
+	packageName := flags.String("package", "client", "package name of a Go client")
+	output := flags.String("o", "", "output file, or standard output if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var client []byte
+	var err error
+	switch *lang {
+	case "go":
+		client, err = source.exportGoClient(*packageName)
+	case "ts":
+		client, err = genTypeScriptClient(source)
+	default:
+		return fmt.Errorf("expected -lang go or ts, received: %q", *lang)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, client)
+}
+
+func genTypeScriptClient(source *apiSource) ([]byte, error) {
+	spec, err := source.loadSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	return zeal.GenerateTypeScriptClient(spec)
+}
+
+func genRoutes(args []string) error {
+	flags := flag.NewFlagSet("gen routes", flag.ContinueOnError)
+	spec := flags.String("spec", "", "OpenAPI spec file, as JSON or YAML")
+	packageName := flags.String("package", "api", "package name of the generated code")
+	output := flags.String("o", "", "output file, or standard output if empty")
+	stubs := flags.String("stubs", "", "handler stubs file, written only if it doesn't exist")
+	models := flags.String("models", "", "models file, in a package of its own, or alongside the routes if empty")
+	modelsImport := flags.String("models-import", "", "import path of the package of the models file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *spec == "" {
+		return errors.New("expected -spec")
+	}
+
+	openAPISpec, err := loadSpecFile(*spec)
+	if err != nil {
+		return err
+	}
+
+	if (*models == "") != (*modelsImport == "") {
+		return errors.New("expected -models and -models-import together")
+	}
+
+	options := zeal.RoutesOptions{PackageName: *packageName, ModelsImport: *modelsImport}
+	routes, err := zeal.GenerateRoutes(openAPISpec, options)
+	if err != nil {
+		return err
+	}
+
+	if err := writeOutput(*output, routes); err != nil {
+		return err
+	}
+
+	if *models != "" {
+		modelsSource, err := zeal.GenerateModels(openAPISpec, options)
+		if err != nil {
+			return err
+		}
+
+		if err := writeOutput(*models, modelsSource); err != nil {
+			return err
+		}
+	}
+
+	if *stubs == "" {
+		return nil
+	}
+
+	// Stubs are edited into the real handlers, so they are never overwritten
+	if _, err := os.Stat(*stubs); err == nil {
+		return nil
+	}
+
+	handlers, err := zeal.GenerateHandlerStubs(openAPISpec, options)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*stubs, handlers, 0o644)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func lint(args []string) error {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	source := addSourceFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	spec, err := source.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	problems := lintSpec(spec)
+	for _, problem := range problems {
+		fmt.Fprintln(os.Stdout, problem)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problems", len(problems))
+	}
+
+	return nil
+}
+
+func lintSpec(spec *openapi3.T) []string {
+	var problems []string
+	if err := spec.Validate(context.Background()); err != nil {
+		problems = append(problems, fmt.Sprintf("invalid spec: %v", err))
+	}
+
+	operations := getOperations(spec)
+	keys := make([]string, 0, len(operations))
+	for key := range operations {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	operationIDs := make(map[string]string)
+	for _, key := range keys {
+		operation := operations[key]
+
+		if operation.OperationID == "" {
+			problems = append(problems, key+": expected operation ID")
+		} else if existing, ok := operationIDs[operation.OperationID]; ok {
+			problems = append(problems, fmt.Sprintf("%v: expected unique operation ID, received duplicate of %v: %v", key, existing, operation.OperationID))
+		} else {
+			operationIDs[operation.OperationID] = key
+		}
+
+		if operation.Summary == "" && operation.Description == "" {
+			problems = append(problems, key+": expected summary or description")
+		}
+
+		if !hasSuccessResponse(operation) {
+			problems = append(problems, key+": expected a 2xx response")
+		}
+	}
+
+	return problems
+}
+
+func hasSuccessResponse(operation *openapi3.Operation) bool {
+	if operation.Responses == nil {
+		return false
+	}
+
+	for status := range operation.Responses.Map() {
+		if strings.HasPrefix(status, "2") {
+			return true
+		}
+	}
+
+	return false
+}
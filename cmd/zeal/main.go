@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const usage = `Usage:
+  zeal spec export (-pkg package | -spec file) [-tags tags] [-format json|yaml] [-o file]
+  zeal spec diff old new
+  zeal gen client -lang go|ts (-pkg package | -spec file) [-tags tags] [-package name] [-o file]
+  zeal gen routes -spec file [-package name] [-o file] [-stubs file] [-models file -models-import path]
+  zeal gen binders [-dir dir] [-o file]
+  zeal lint (-pkg package | -spec file) [-tags tags]
+
+A package is the main package of a program which calls zeal.ExportAPI.
+It is run with the zeal build tag, to export its API instead of serving it.
+`
+
+var commands = map[string]func(args []string) error{
+	"spec export": specExport,
+	"spec diff":   specDiff,
+	"gen client":  genClient,
+	"gen routes":  genRoutes,
+	"gen binders": genBinders,
+	"lint":        lint,
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "zeal: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	for i := 1; i <= 2 && i <= len(args); i++ {
+		if command, ok := commands[strings.Join(args[:i], " ")]; ok {
+			return command(args[i:])
+		}
+	}
+
+	fmt.Fprint(os.Stderr, usage)
+
+	return errors.New("expected command")
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/DandyCodes/zeal"
+	"github.com/DandyCodes/zeal/breaking"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/invopop/yaml"
+)
+
+// apiSource is either a spec file or a program which calls zeal.ExportAPI
+type apiSource struct {
+	pkg  *string
+	spec *string
+	tags *string
+}
+
+func addSourceFlags(flags *flag.FlagSet) *apiSource {
+	return &apiSource{
+		pkg:  flags.String("pkg", "", "main package of a program which calls zeal.ExportAPI"),
+		spec: flags.String("spec", "", "OpenAPI spec file, as JSON or YAML"),
+		tags: flags.String("tags", "", "additional build tags for the program, besides "+zeal.ExportBuildTag),
+	}
+}
+
+func (s *apiSource) validate() error {
+	if (*s.pkg == "") == (*s.spec == "") {
+		return errors.New("expected one of -pkg or -spec")
+	}
+
+	return nil
+}
+
+func (s *apiSource) loadSpec() (*openapi3.T, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	if *s.spec != "" {
+		return loadSpecFile(*s.spec)
+	}
+
+	outputs, err := s.runProgram(map[string]string{zeal.ExportSpecEnv: "openapi.json"})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi3.NewLoader().LoadFromData(outputs[zeal.ExportSpecEnv])
+}
+
+func (s *apiSource) exportGoClient(packageName string) ([]byte, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	if *s.pkg == "" {
+		return nil, errors.New("expected -pkg for a Go client, as it is generated from registered routes rather than a spec file")
+	}
+
+	outputs, err := s.runProgram(map[string]string{zeal.ExportGoClientEnv: "client.go"}, zeal.ExportGoPackageEnv+"="+packageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs[zeal.ExportGoClientEnv], nil
+}
+
+// runProgram runs the program with each environment variable set to a path to export to, and returns what was exported
+func (s *apiSource) runProgram(exports map[string]string, env ...string) (map[string][]byte, error) {
+	dir, err := os.MkdirTemp("", "zeal")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tags := zeal.ExportBuildTag
+	if *s.tags != "" {
+		tags += "," + *s.tags
+	}
+
+	command := exec.Command("go", "run", "-tags", tags, *s.pkg)
+	command.Env = append(os.Environ(), env...)
+	for name, file := range exports {
+		command.Env = append(command.Env, name+"="+filepath.Join(dir, file))
+	}
+	// Standard output is kept free for the command's own output
+	command.Stdout = os.Stderr
+	command.Stderr = os.Stderr
+
+	if err := command.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run %v: %w", *s.pkg, err)
+	}
+
+	outputs := make(map[string][]byte)
+	for name, file := range exports {
+		output, err := os.ReadFile(filepath.Join(dir, file))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("expected %v to call zeal.ExportAPI, received no output", *s.pkg)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[name] = output
+	}
+
+	return outputs, nil
+}
+
+func loadSpecFile(path string) (*openapi3.T, error) {
+	spec, err := openapi3.NewLoader().LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load spec %v: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+func specExport(args []string) error {
+	flags := flag.NewFlagSet("spec export", flag.ContinueOnError)
+	source := addSourceFlags(flags)
+	output := flags.String("o", "", "output file, or standard output if empty")
+	format := flags.String("format", "", "json or yaml, inferred from the output file if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	spec, err := source.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	if *format == "" {
+		*format = "json"
+		if ext := filepath.Ext(*output); ext == ".yaml" || ext == ".yml" {
+			*format = "yaml"
+		}
+	}
+
+	encoded, err := marshalSpec(spec, *format)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, encoded)
+}
+
+func marshalSpec(spec *openapi3.T, format string) ([]byte, error) {
+	encoded, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "json":
+		return append(encoded, '\n'), nil
+	case "yaml":
+		return yaml.JSONToYAML(encoded)
+	default:
+		return nil, fmt.Errorf("expected format json or yaml, received: %v", format)
+	}
+}
+
+func writeOutput(path string, output []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(output)
+		return err
+	}
+
+	return os.WriteFile(path, output, 0o644)
+}
+
+func specDiff(args []string) error {
+	flags := flag.NewFlagSet("spec diff", flag.ContinueOnError)
+	format := flags.String("format", "text", "text, json or sarif")
+	failOn := flags.String("fail-on", "breaking", "breaking, any or none")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		return errors.New("expected old and new spec files")
+	}
+
+	oldSpec, err := loadSpecFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	newSpec, err := loadSpecFile(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	changes := breaking.Compare(oldSpec, newSpec)
+	switch *format {
+	case "text":
+		err = breaking.WriteText(os.Stdout, changes)
+	case "json":
+		err = breaking.WriteJSON(os.Stdout, changes)
+	case "sarif":
+		err = breaking.WriteSARIF(os.Stdout, changes, filepath.ToSlash(flags.Arg(1)))
+	default:
+		return fmt.Errorf("expected format text, json or sarif, received: %v", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch *failOn {
+	case "breaking":
+		if breaking.HasBreaking(changes) {
+			return errors.New("found breaking changes")
+		}
+	case "any":
+		if len(changes) > 0 {
+			return fmt.Errorf("found %d changes", len(changes))
+		}
+	case "none":
+	default:
+		return fmt.Errorf("expected -fail-on breaking, any or none, received: %v", *failOn)
+	}
+
+	return nil
+}
+
+func getOperations(spec *openapi3.T) map[string]*openapi3.Operation {
+	operations := make(map[string]*openapi3.Operation)
+	if spec.Paths == nil {
+		return operations
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			operations[method+" "+path] = operation
+		}
+	}
+
+	return operations
+}
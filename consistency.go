@@ -0,0 +1,168 @@
+package zeal
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+type patternWildcard struct {
+	name   string
+	isRest bool
+}
+
+func checkRoute(info RouteInfo) error {
+	var errs []error
+
+	switch info.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		if info.BodyType != nil {
+			errs = append(errs, &RouteError{
+				Pattern: info.Pattern,
+				Field:   getTypeName(HasBody[any]{}),
+				Err:     fmt.Errorf("expected no request body for %v route, received: %v", info.Method, info.BodyType),
+			})
+		}
+	}
+
+	errs = append(errs, checkParams(info)...)
+
+	return errors.Join(errs...)
+}
+
+func (m *ZealMux) claimRoute(method, path, pattern string) error {
+	if method == "" || path == "" {
+		return nil
+	}
+
+	key := method + " " + getRouteShape(path)
+	claimedPattern, claimed := m.routes[key]
+	if claimed && claimedPattern != pattern {
+		return &RouteError{
+			Pattern: pattern,
+			Err:     fmt.Errorf("expected unique route, received collision with: %v", claimedPattern),
+		}
+	}
+	if claimed {
+		return &RouteError{Pattern: pattern, Err: fmt.Errorf("expected unique route, received duplicate")}
+	}
+
+	m.routes[key] = pattern
+
+	return nil
+}
+
+func checkParams(info RouteInfo) []error {
+	wildcards := getPatternWildcards(info.Path)
+
+	var fields []reflect.StructField
+	if info.ParamsType != nil && info.ParamsType.Kind() == reflect.Struct {
+		fields = reflect.VisibleFields(info.ParamsType)
+	}
+
+	var errs []error
+	for _, wildcard := range wildcards {
+		fieldIndex := slices.IndexFunc(fields, func(field reflect.StructField) bool {
+			return field.Name == wildcard.name
+		})
+		if fieldIndex < 0 {
+			errs = append(errs, &RouteError{
+				Pattern: info.Pattern,
+				Field:   wildcard.name,
+				Err:     fmt.Errorf("expected params field for wildcard {%v}, received none", wildcard.name),
+			})
+			continue
+		}
+
+		field := fields[fieldIndex]
+		if field.Tag.Get("in") == "query" {
+			errs = append(errs, &RouteError{
+				Pattern: info.Pattern,
+				Field:   field.Name,
+				Err:     fmt.Errorf("expected path param for wildcard {%v}, received field tagged as query param", wildcard.name),
+			})
+		}
+
+		if wildcard.isRest && field.Type.Kind() != reflect.String {
+			errs = append(errs, &RouteError{
+				Pattern: info.Pattern,
+				Field:   field.Name,
+				Err:     fmt.Errorf("expected string field for wildcard {%v...}, received: %v", wildcard.name, field.Type),
+			})
+		}
+	}
+
+	for _, field := range fields {
+		in := field.Tag.Get("in")
+		switch in {
+		case "", "query":
+		case "path":
+			isWildcard := slices.ContainsFunc(wildcards, func(wildcard patternWildcard) bool {
+				return wildcard.name == field.Name
+			})
+			if !isWildcard {
+				errs = append(errs, &RouteError{
+					Pattern: info.Pattern,
+					Field:   field.Name,
+					Err:     fmt.Errorf("expected wildcard {%v} in pattern, received none", field.Name),
+				})
+			}
+		default:
+			errs = append(errs, &RouteError{
+				Pattern: info.Pattern,
+				Field:   field.Name,
+				Err:     fmt.Errorf("expected in tag of path or query, received: %v", in),
+			})
+		}
+	}
+
+	return errs
+}
+
+func getPatternWildcards(path string) []patternWildcard {
+	var wildcards []patternWildcard
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") || segment == "{$}" {
+			continue
+		}
+
+		name := segment[1 : len(segment)-1]
+		name, _, _ = strings.Cut(name, ":")
+		wildcard := patternWildcard{name: strings.TrimSuffix(name, "..."), isRest: strings.HasSuffix(name, "...")}
+		wildcards = append(wildcards, wildcard)
+	}
+
+	return wildcards
+}
+
+func getRouteShape(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") || segment == "{$}" {
+			continue
+		}
+
+		if strings.HasSuffix(segment, "...}") {
+			segments[i] = "{...}"
+		} else {
+			segments[i] = "{}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func getDocumentedPath(path string) string {
+	path = strings.TrimSuffix(path, "{$}")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "...}") {
+			segments[i] = strings.TrimSuffix(segment, "...}") + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,165 @@
+package zeal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+type ContractTestOptions struct {
+	// Report defaults to log.Print
+	Report func(args ...any)
+}
+
+func (m *ZealMux) EnableContractTest(options ...ContractTestOptions) {
+	var contractOptions ContractTestOptions
+	if len(options) > 0 {
+		contractOptions = options[0]
+	}
+	if contractOptions.Report == nil {
+		contractOptions.Report = log.Print
+	}
+
+	m.spec.mu.Lock()
+	defer m.spec.mu.Unlock()
+
+	m.contractTest = &contractOptions
+}
+
+type ContractError struct {
+	Pattern string
+	Status  int
+	Err     error
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("response %v to %v violates the spec: %v", e.Status, e.Pattern, e.Err)
+}
+
+func (e *ContractError) Unwrap() error {
+	return e.Err
+}
+
+func (m *ZealMux) getContractTest() *ContractTestOptions {
+	for mux := m; mux != nil; mux = mux.parent {
+		if mux.contractTest != nil {
+			return mux.contractTest
+		}
+	}
+
+	return nil
+}
+
+// checkContract returns the writer the handler should use, and a function which checks what was written
+func (r *Route) checkContract(info RouteInfo, w http.ResponseWriter, request *http.Request) (http.ResponseWriter, func()) {
+	var options *ContractTestOptions
+	r.ZealMux.readRegistry(func() {
+		options = r.ZealMux.getContractTest()
+	})
+	if options == nil {
+		return w, func() {}
+	}
+
+	recorder := &contractRecorder{ResponseWriter: w}
+
+	return recorder, func() {
+		if !recorder.wroteHeader {
+			recorder.status = http.StatusOK
+		}
+
+		err := r.ZealMux.ValidateResponse(info, request, recorder.status, recorder.Header(), recorder.body.Bytes())
+		if err != nil {
+			options.Report(&ContractError{Pattern: info.Pattern, Status: recorder.status, Err: err})
+		}
+	}
+}
+
+func (m *ZealMux) ValidateResponse(info RouteInfo, request *http.Request, status int, header http.Header, body []byte) error {
+	route, err := m.getSpecRoute(info)
+	if err != nil {
+		return err
+	}
+
+	validationOptions := &openapi3filter.Options{IncludeResponseStatus: true, MultiError: true}
+	validationOptions.WithCustomSchemaErrorFunc(getSchemaErrorMessage)
+
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{Request: request, Route: route},
+		Status:                 status,
+		Header:                 header,
+		Options:                validationOptions,
+	}
+	input.SetBodyBytes(body)
+
+	err = openapi3filter.ValidateResponse(request.Context(), input)
+
+	var responseErr *openapi3filter.ResponseError
+	if errors.As(err, &responseErr) && responseErr.Err == nil {
+		return errors.New(strings.TrimSpace(responseErr.Reason))
+	}
+
+	return err
+}
+
+// getSpecRoute finds the route's operation. Its path has the same form in the spec, so no router is needed.
+func (m *ZealMux) getSpecRoute(info RouteInfo) (*routers.Route, error) {
+	spec, err := m.Spec()
+	if err != nil {
+		return nil, err
+	}
+
+	pathItem := spec.Paths.Find(info.Path)
+	if pathItem == nil || pathItem.GetOperation(info.Method) == nil {
+		return nil, fmt.Errorf("expected operation %v in the spec, received none", info.Pattern)
+	}
+
+	return &routers.Route{
+		Spec:      spec,
+		Path:      info.Path,
+		PathItem:  pathItem,
+		Method:    info.Method,
+		Operation: pathItem.GetOperation(info.Method),
+	}, nil
+}
+
+// getSchemaErrorMessage leaves out the schema and value, which make logs hard to read
+func getSchemaErrorMessage(err *openapi3.SchemaError) string {
+	return fmt.Sprintf("%v at /%v", err.Reason, strings.Join(err.JSONPointer(), "/"))
+}
+
+// contractRecorder passes the response through, keeping a copy of it to validate
+type contractRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *contractRecorder) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = statusCode, true
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *contractRecorder) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.status, w.wroteHeader = http.StatusOK, true
+	}
+
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *contractRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
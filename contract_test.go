@@ -0,0 +1,36 @@
+package zeal
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContractTestChecksBindingErrors(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	var reported []error
+	mux.EnableContractTest(ContractTestOptions{Report: func(args ...any) {
+		reported = append(reported, args[0].(error))
+	}})
+
+	var route = NewRoute[struct {
+		Route
+		HasParams[struct {
+			ID int `in:"path"`
+		}]
+	}](mux)
+	route.HandleFunc("GET /items/{ID}", func(w http.ResponseWriter, r *http.Request) {})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items/abc", nil))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected status %v, received: %v", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	var contractErr *ContractError
+	if len(reported) != 1 || !errors.As(reported[0], &contractErr) || contractErr.Status != http.StatusUnprocessableEntity {
+		t.Fatalf("expected the undocumented %v response to be reported, received: %v", http.StatusUnprocessableEntity, reported)
+	}
+}
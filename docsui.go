@@ -0,0 +1,155 @@
+package zeal
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:generate go run ./internal/fetchdocsui
+//go:embed docsui
+var docsUIFiles embed.FS
+
+// docsUIFS is replaced in tests, which can't depend on the bundles having been fetched
+var docsUIFS fs.FS = docsUIFiles
+
+type DocsUI int
+
+const (
+	UIRedoc DocsUI = iota
+	UIScalar
+	UIRapiDoc
+)
+
+type docsUIFile struct {
+	template string
+	asset    string
+}
+
+var docsUIFileNames = map[DocsUI]docsUIFile{
+	UIRedoc:   {template: "redoc.html", asset: "redoc.standalone.js"},
+	UIScalar:  {template: "scalar.html", asset: "scalar.standalone.js"},
+	UIRapiDoc: {template: "rapidoc.html", asset: "rapidoc-min.js"},
+}
+
+type DocsUIOptions struct {
+	Title        string
+	DarkMode     bool
+	PrimaryColor string
+	PersistAuth  bool
+}
+
+func ServeDocsUI(mux *ZealMux, openAPISpec *openapi3.T, pattern string, ui DocsUI, options ...DocsUIOptions) error {
+	fileNames, ok := docsUIFileNames[ui]
+	if !ok {
+		return fmt.Errorf("expected UIRedoc, UIScalar or UIRapiDoc, received: %d", ui)
+	}
+
+	var uiOptions DocsUIOptions
+	if len(options) > 0 {
+		uiOptions = options[0]
+	}
+	if uiOptions.Title == "" {
+		uiOptions.Title = mux.Api.Name
+		if openAPISpec != nil && openAPISpec.Info != nil {
+			uiOptions.Title = openAPISpec.Info.Title
+		}
+	}
+
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		method, path = http.MethodGet, pattern
+	}
+	path = strings.TrimSuffix(path, "/")
+
+	asset, err := fs.ReadFile(docsUIFS, "docsui/"+fileNames.asset)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("expected embedded docs UI asset %v, received none: run go generate in the zeal module", fileNames.asset)
+	}
+	if err != nil {
+		return err
+	}
+
+	page, err := renderDocsUI(fileNames, uiOptions, ui)
+	if err != nil {
+		return err
+	}
+
+	specHandler, err := newSpecHandler(mux, openAPISpec, encodeSpecJSON, "application/json")
+	if err != nil {
+		return err
+	}
+
+	pageHandler, err := newStaticHandler(page, "text/html; charset=utf-8")
+	if err != nil {
+		return err
+	}
+
+	assetHandler, err := newStaticHandler(asset, "text/javascript; charset=utf-8")
+	if err != nil {
+		return err
+	}
+
+	mux.Handle(method+" "+path+"/{$}", pageHandler)
+	mux.Handle(method+" "+path+"/"+fileNames.asset, assetHandler)
+	mux.Handle(method+" "+path+"/openapi.json", specHandler)
+
+	return nil
+}
+
+func renderDocsUI(fileNames docsUIFile, options DocsUIOptions, ui DocsUI) ([]byte, error) {
+	pageTemplate, err := template.ParseFS(docsUIFS, "docsui/"+fileNames.template)
+	if err != nil {
+		return nil, err
+	}
+
+	theme := "light"
+	if options.DarkMode {
+		theme = "dark"
+	}
+
+	var config any
+	switch ui {
+	case UIRedoc:
+		redocConfig := map[string]any{}
+		if options.PrimaryColor != "" {
+			redocConfig["theme"] = map[string]any{"colors": map[string]any{"primary": map[string]any{"main": options.PrimaryColor}}}
+		}
+		config = redocConfig
+	case UIScalar:
+		scalarConfig := map[string]any{"darkMode": options.DarkMode, "persistAuth": options.PersistAuth}
+		if options.PrimaryColor != "" {
+			scalarConfig["customCss"] = fmt.Sprintf(":root { --scalar-color-accent: %v; }", options.PrimaryColor)
+		}
+		encoded, err := json.Marshal(scalarConfig)
+		if err != nil {
+			return nil, err
+		}
+		config = string(encoded)
+	}
+
+	// Relative URLs keep the page working behind prefixes and StripPrefix
+	var page bytes.Buffer
+	err = pageTemplate.Execute(&page, map[string]any{
+		"Title":        options.Title,
+		"Asset":        "./" + fileNames.asset,
+		"SpecURL":      "./openapi.json",
+		"Config":       config,
+		"Theme":        theme,
+		"PrimaryColor": options.PrimaryColor,
+		"PersistAuth":  options.PersistAuth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Bytes(), nil
+}
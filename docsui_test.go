@@ -0,0 +1,115 @@
+package zeal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// useDocsUIFiles serves the embedded templates, with stand-ins for any bundles which haven't been fetched
+func useDocsUIFiles(t *testing.T, withBundles bool) {
+	t.Helper()
+
+	files := fstest.MapFS{}
+	for _, fileNames := range docsUIFileNames {
+		template, err := docsUIFiles.ReadFile("docsui/" + fileNames.template)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files["docsui/"+fileNames.template] = &fstest.MapFile{Data: template}
+
+		if !withBundles {
+			continue
+		}
+
+		asset, err := docsUIFiles.ReadFile("docsui/" + fileNames.asset)
+		if errors.Is(err, fs.ErrNotExist) {
+			asset = []byte("// " + fileNames.asset)
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		files["docsui/"+fileNames.asset] = &fstest.MapFile{Data: asset}
+	}
+
+	docsUIFS = files
+	t.Cleanup(func() {
+		docsUIFS = docsUIFiles
+	})
+}
+
+func TestServeDocsUI(t *testing.T) {
+	useDocsUIFiles(t, true)
+
+	for ui, fileNames := range docsUIFileNames {
+		mux := NewZealMux(http.NewServeMux(), "Menu API")
+		var route = NewRoute[Route](mux)
+		route.HandleFunc("GET /menus", func(w http.ResponseWriter, r *http.Request) {})
+
+		if err := ServeDocsUI(mux, nil, "GET /docs/", ui, DocsUIOptions{DarkMode: true}); err != nil {
+			t.Fatalf("expected %v to be served, received: %v", fileNames.template, err)
+		}
+
+		for target, expected := range map[string]string{
+			"/docs/":                   "./" + fileNames.asset,
+			"/docs/" + fileNames.asset: "",
+			"/docs/openapi.json":       `"/menus"`,
+		} {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+
+			if w.Code != http.StatusOK || w.Body.Len() == 0 || !strings.Contains(w.Body.String(), expected) {
+				t.Errorf("expected %v of %v to respond 200 with %q, received: %v %.200q", target, fileNames.template, expected, w.Code, w.Body.String())
+			}
+		}
+
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/", nil))
+		if !strings.Contains(w.Body.String(), "<title>Menu API</title>") {
+			t.Errorf("expected %v to be titled by the API name, received: %.300q", fileNames.template, w.Body.String())
+		}
+	}
+}
+
+func TestServeDocsUIWithoutBundles(t *testing.T) {
+	useDocsUIFiles(t, false)
+
+	err := ServeDocsUI(NewZealMux(http.NewServeMux()), nil, "GET /docs/", UIRedoc)
+	if err == nil || !strings.Contains(err.Error(), "go generate") {
+		t.Fatalf("expected an error asking for go generate, received: %v", err)
+	}
+}
+
+func TestEmbeddedDocsUIBundlesArePinned(t *testing.T) {
+	sums, err := docsUIFiles.ReadFile("docsui/SHA256SUMS")
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("docs UI bundles aren't vendored: run go run ./internal/fetchdocsui -update")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checksums := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(sums)), "\n") {
+		checksum, name, _ := strings.Cut(line, "  ")
+		checksums[name] = checksum
+	}
+
+	for _, fileNames := range docsUIFileNames {
+		asset, err := docsUIFiles.ReadFile("docsui/" + fileNames.asset)
+		if err != nil {
+			t.Errorf("expected embedded bundle %v, received: %v", fileNames.asset, err)
+			continue
+		}
+
+		sum := sha256.Sum256(asset)
+		if checksum := hex.EncodeToString(sum[:]); checksum != checksums[fileNames.asset] {
+			t.Errorf("expected SHA-256 %v for %v, received: %v", checksums[fileNames.asset], fileNames.asset, checksum)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package zeal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+type RouteError struct {
+	Pattern string
+	Field   string
+	Err     error
+}
+
+func (e *RouteError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid route %v: %v", e.Pattern, e.Err)
+	}
+
+	return fmt.Sprintf("invalid route %v: field %v: %v", e.Pattern, e.Field, e.Err)
+}
+
+func (e *RouteError) Unwrap() error {
+	return e.Err
+}
+
+func (m *ZealMux) Validate() error {
+	return errors.Join(*m.errs...)
+}
+
+func (m *ZealMux) MustValidate() {
+	if err := m.Validate(); err != nil {
+		panic(err)
+	}
+}
+
+func (m *ZealMux) addError(err error) {
+	if slices.Contains(*m.errs, err) {
+		return
+	}
+
+	*m.errs = append(*m.errs, err)
+}
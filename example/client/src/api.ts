@@ -0,0 +1,133 @@
+// Code generated by zeal. DO NOT EDIT.
+
+export type Item = {
+  Name: string;
+  Price: number;
+};
+
+export type Menu = {
+  ID: number;
+  Items: Array<Item> | null;
+};
+
+export class ApiError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly body: unknown,
+  ) {
+    super(`unexpected status ${status}`);
+    this.name = "ApiError";
+  }
+}
+
+export type ClientOptions = {
+  baseUrl?: string;
+  fetch?: typeof fetch;
+  headers?: Record<string, string>;
+};
+
+export type RequestOptions = Omit<RequestInit, "method" | "body">;
+
+type QueryValue = string | number | boolean | null | undefined;
+
+export class Client {
+  private readonly baseUrl: string;
+  private readonly fetch: typeof fetch;
+  private readonly headers: Record<string, string>;
+
+  constructor(options: ClientOptions = {}) {
+    this.baseUrl = options.baseUrl ?? "";
+    this.fetch = options.fetch ?? globalThis.fetch.bind(globalThis);
+    this.headers = options.headers ?? {};
+  }
+
+  private async request<T>(
+    method: string,
+    path: string,
+    query: Record<string, QueryValue | QueryValue[]>,
+    headers: Record<string, QueryValue>,
+    body: unknown,
+    options: RequestOptions = {},
+  ): Promise<T> {
+    const search = new URLSearchParams();
+    for (const [name, value] of Object.entries(query)) {
+      for (const item of Array.isArray(value) ? value : [value]) {
+        if (item !== undefined && item !== null) {
+          search.append(name, String(item));
+        }
+      }
+    }
+
+    const requestHeaders = new Headers(options.headers);
+    for (const [name, value] of Object.entries({ ...this.headers, ...headers })) {
+      if (value !== undefined && value !== null) {
+        requestHeaders.set(name, String(value));
+      }
+    }
+    requestHeaders.set("Accept", "application/json");
+    if (body !== undefined) {
+      requestHeaders.set("Content-Type", "application/json");
+    }
+
+    const queryString = search.toString();
+    const response = await this.fetch(this.baseUrl + path + (queryString ? "?" + queryString : ""), {
+      ...options,
+      method,
+      headers: requestHeaders,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+
+    const text = await response.text();
+    let data: unknown = text;
+    if (text && response.headers.get("Content-Type")?.includes("json")) {
+      data = JSON.parse(text);
+    }
+
+    if (!response.ok) {
+      throw new ApiError(response.status, data);
+    }
+
+    return data as T;
+  }
+
+  /** GET /answer */
+  async getAnswer(options?: RequestOptions): Promise<number> {
+    return this.request("GET", `/answer`, {}, {}, undefined, options);
+  }
+
+  /** POST /hello */
+  async postHello(options?: RequestOptions): Promise<string> {
+    return this.request("POST", `/hello`, {}, {}, undefined, options);
+  }
+
+  /** PUT /items */
+  async putItems(body: Item, options?: RequestOptions): Promise<string> {
+    return this.request("PUT", `/items`, {}, {}, body, options);
+  }
+
+  /** POST /items/{MenuID} */
+  async postItemsByMenuID(params: {
+    MenuID: number;
+  }, body: Item, options?: RequestOptions): Promise<Item> {
+    return this.request("POST", `/items/${encodeURIComponent(String(params.MenuID))}`, {}, {}, body, options);
+  }
+
+  /**
+   * List menus
+   *
+   * Lists every menu along with its items.
+   *
+   * GET /menus
+   */
+  async getMenus(options?: RequestOptions): Promise<Array<Menu> | null> {
+    return this.request("GET", `/menus`, {}, {}, undefined, options);
+  }
+
+  /** DELETE /menus/{ID} */
+  async deleteMenusByID(params: {
+    Quiet: boolean;
+    ID: number;
+  }, options?: RequestOptions): Promise<string> {
+    return this.request("DELETE", `/menus/${encodeURIComponent(String(params.ID))}`, {Quiet: params.Quiet}, {}, undefined, options);
+  }
+}
@@ -1,7 +0,0 @@
-export type ApiResult<TData = any> = {
-	readonly body: TData;
-	readonly ok: boolean;
-	readonly status: number;
-	readonly statusText: string;
-	readonly url: string;
-};
\ No newline at end of file
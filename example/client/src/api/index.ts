@@ -1,8 +0,0 @@
-// This file is auto-generated by @hey-api/openapi-ts
-
-export { ApiError } from './core/ApiError';
-export { CancelablePromise, CancelError } from './core/CancelablePromise';
-export { OpenAPI, type OpenAPIConfig } from './core/OpenAPI';
-export * from './models';
-export * from './schemas';
-export * from './services';
\ No newline at end of file
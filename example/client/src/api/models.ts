@@ -1,68 +0,0 @@
-// This file is auto-generated by @hey-api/openapi-ts
-
-
-export type Item = {
-    Name: string;
-    Price: number;
-};
-
-export type Menu = {
-    ID: number;
-    Items: Array<Item> | null;
-};
-
-export type $OpenApiTs = {
-    '/answer': {
-        get: {
-            res: {
-                200: number;
-            };
-        };
-    };
-    '/hello': {
-        post: {
-            res: {
-                200: string;
-            };
-        };
-    };
-    '/items': {
-        put: {
-            req: {
-                requestBody: Item;
-            };
-            res: {
-                200: string;
-            };
-        };
-    };
-    '/items/{MenuID}': {
-        post: {
-            req: {
-                menuId: number;
-                requestBody: Item;
-            };
-            res: {
-                200: Item;
-            };
-        };
-    };
-    '/menus': {
-        get: {
-            res: {
-                200: Array<Menu> | null;
-            };
-        };
-    };
-    '/menus/{ID}': {
-        delete: {
-            req: {
-                id: number;
-                quiet: boolean;
-            };
-            res: {
-                200: string;
-            };
-        };
-    };
-};
\ No newline at end of file
@@ -1,6 +0,0 @@
-import { createClient } from '@hey-api/openapi-ts'
-
-createClient({
-    input: 'http://localhost:3975/swagger-ui/swagger.json',
-    output: 'src/api',
-})
\ No newline at end of file
@@ -1,14 +1,14 @@
-import { OpenAPI, DefaultService } from "./api";
+import { Client } from "./api";
 
-OpenAPI.BASE = "/api"
+const client = new Client({ baseUrl: "/api" })
 
-await DefaultService.postHello()
+await client.postHello()
 
-const answer = await DefaultService.getAnswer()
+const answer = await client.getAnswer()
 console.log(answer)
 
-const item = await DefaultService.postItemsByMenuId({ menuId: 1, requestBody: { Name: "updatedItem", Price: 22.2 } })
+const item = await client.postItemsByMenuID({ MenuID: 1 }, { Name: "updatedItem", Price: 22.2 })
 console.log(item)
 
-await DefaultService.putItems({ requestBody: { Name: "newItem", Price: 33.3 } })
-await DefaultService.deleteMenusById({ id: 2, quiet: false })
+await client.putItems({ Name: "newItem", Price: 33.3 })
+await client.deleteMenusByID({ ID: 2, Quiet: false })
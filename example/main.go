@@ -24,6 +24,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create OpenAPI spec: %v", err)
 	}
+	zeal.ExportAPI(mux, openAPISpec)
+
+	zeal.ServeSpec(mux, openAPISpec, "GET /docs/", zeal.ServeSpecOptions{Document: true})
 
 	port := 3975
 	swaggerPattern := "/swagger-ui/"
@@ -71,7 +74,12 @@ func addRoutes(mux *zeal.ZealMux) {
 		zeal.Route
 		zeal.HasResponse[[]models.Menu]
 	}
-	var getMenus = zeal.NewRoute[GetMenus](mux)
+	var getMenus = zeal.NewRoute[GetMenus](mux, zeal.RouteOptions{
+		OperationID: "getMenus",
+		Summary:     "List menus",
+		Description: "Lists every menu along with its items.",
+		Tags:        []string{"menus"},
+	})
 	getMenus.HandleFunc("GET /menus", func(w http.ResponseWriter, r *http.Request) {
 		getMenus.Response(menus)
 	})
@@ -1,8 +1,8 @@
 package models
 
 type Item struct {
-	Name  string
-	Price float32
+	Name  string  `example:"Steak"`
+	Price float32 `example:"13.95"`
 }
 
 type Menu struct {
@@ -0,0 +1,192 @@
+package zeal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type RouteExample struct {
+	Name        string
+	Summary     string
+	Description string
+	Params      any
+	Body        any
+	Status      int
+	Response    any
+}
+
+func (example RouteExample) customizeOperation(operation *openapi3.Operation) error {
+	if example.Params != nil {
+		if err := example.addParams(operation); err != nil {
+			return err
+		}
+	}
+
+	if example.Body != nil {
+		if err := example.addBody(operation); err != nil {
+			return err
+		}
+	}
+
+	if example.Response != nil {
+		if err := example.addResponse(operation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (example RouteExample) addParams(operation *openapi3.Operation) error {
+	paramsValue := reflect.Indirect(reflect.ValueOf(example.Params))
+	if paramsValue.Kind() != reflect.Struct {
+		return fmt.Errorf("expected params struct for example %v, received: %v", example.Name, paramsValue.Kind())
+	}
+
+	for i := 0; i < paramsValue.NumField(); i++ {
+		field := paramsValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		parameter := operation.Parameters.GetByInAndName(openapi3.ParameterInPath, field.Name)
+		if parameter == nil {
+			parameter = operation.Parameters.GetByInAndName(openapi3.ParameterInQuery, field.Name)
+		}
+		if parameter == nil {
+			return fmt.Errorf("expected documented param for example %v, received: %v", example.Name, field.Name)
+		}
+
+		paramExample, err := example.newExample(paramsValue.Field(i).Interface())
+		if err != nil {
+			return err
+		}
+
+		if parameter.Examples == nil {
+			parameter.Examples = make(openapi3.Examples)
+		}
+		parameter.Examples[example.Name] = &openapi3.ExampleRef{Value: paramExample}
+	}
+
+	return nil
+}
+
+func (example RouteExample) addBody(operation *openapi3.Operation) error {
+	if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return fmt.Errorf("expected request body for example: %v", example.Name)
+	}
+
+	return example.addMediaType(operation.RequestBody.Value.Content, example.Body)
+}
+
+func (example RouteExample) addResponse(operation *openapi3.Operation) error {
+	status := example.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	response := operation.Responses.Status(status)
+	if response == nil {
+		okResponse := operation.Responses.Status(http.StatusOK)
+		if okResponse == nil || okResponse.Value == nil {
+			return fmt.Errorf("expected response for example: %v", example.Name)
+		}
+
+		jsonContent := okResponse.Value.Content.Get("application/json")
+		newResponse := openapi3.NewResponse().
+			WithDescription("").
+			WithContent(openapi3.NewContentWithJSONSchemaRef(jsonContent.Schema))
+		operation.AddResponse(status, newResponse)
+		response = operation.Responses.Status(status)
+	}
+
+	return example.addMediaType(response.Value.Content, example.Response)
+}
+
+func (example RouteExample) addMediaType(content openapi3.Content, value any) error {
+	mediaType := content.Get("application/json")
+	if mediaType == nil {
+		return fmt.Errorf("expected JSON content for example: %v", example.Name)
+	}
+
+	mediaTypeExample, err := example.newExample(value)
+	if err != nil {
+		return err
+	}
+
+	if mediaType.Examples == nil {
+		mediaType.Examples = make(openapi3.Examples)
+	}
+	mediaType.Examples[example.Name] = &openapi3.ExampleRef{Value: mediaTypeExample}
+
+	return nil
+}
+
+func (example RouteExample) newExample(value any) (*openapi3.Example, error) {
+	jsonValue, err := toJSONValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize example %v: %w", example.Name, err)
+	}
+
+	newExample := openapi3.NewExample(jsonValue)
+	newExample.Summary = example.Summary
+	newExample.Description = example.Description
+
+	return newExample, nil
+}
+
+func getTypeExamples(exampleType reflect.Type) map[string]any {
+	examples := make(map[string]any)
+	if exampleType == nil {
+		return examples
+	}
+
+	method := reflect.New(exampleType).MethodByName("Examples")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return examples
+	}
+
+	examplesType := method.Type().Out(0)
+	if examplesType.Kind() != reflect.Map || examplesType.Key().Kind() != reflect.String {
+		return examples
+	}
+
+	examplesMap := method.Call([]reflect.Value{})[0].MapRange()
+	for examplesMap.Next() {
+		examples[examplesMap.Key().String()] = examplesMap.Value().Interface()
+	}
+
+	return examples
+}
+
+func parseExample(rawExample string, exampleType reflect.Type) any {
+	example := reflect.New(exampleType)
+	if err := json.Unmarshal([]byte(rawExample), example.Interface()); err != nil {
+		return rawExample
+	}
+
+	jsonValue, err := toJSONValue(example.Elem().Interface())
+	if err != nil {
+		return rawExample
+	}
+
+	return jsonValue
+}
+
+func toJSONValue(value any) (any, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonValue any
+	if err := json.Unmarshal(valueBytes, &jsonValue); err != nil {
+		return nil, err
+	}
+
+	return jsonValue, nil
+}
@@ -0,0 +1,57 @@
+package zeal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Set by the zeal command when it runs a program to export its API
+const (
+	ExportSpecEnv      = "ZEAL_EXPORT_SPEC"
+	ExportGoClientEnv  = "ZEAL_EXPORT_GO_CLIENT"
+	ExportGoPackageEnv = "ZEAL_EXPORT_GO_PACKAGE"
+	ExportBuildTag     = "zeal"
+)
+
+func ExportAPI(mux *ZealMux, openAPISpec *openapi3.T) {
+	specPath, goClientPath := os.Getenv(ExportSpecEnv), os.Getenv(ExportGoClientEnv)
+	if specPath == "" && goClientPath == "" {
+		return
+	}
+
+	if err := exportAPI(mux, openAPISpec, specPath, goClientPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to export API: %v\n", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func exportAPI(mux *ZealMux, openAPISpec *openapi3.T, specPath, goClientPath string) error {
+	if specPath != "" {
+		spec, err := json.MarshalIndent(openAPISpec, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(specPath, append(spec, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+
+	if goClientPath != "" {
+		source, err := GenerateGoClient(GoClientOptions{ZealMux: mux, PackageName: os.Getenv(ExportGoPackageEnv)})
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(goClientPath, source, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
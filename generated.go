@@ -0,0 +1,126 @@
+package zeal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+var (
+	paramsBinders    sync.Map
+	bodyDecoders     sync.Map
+	responseEncoders sync.Map
+)
+
+func RegisterParamsBinder[T_Params any](bind func(r *http.Request, params *T_Params) error) {
+	paramsBinders.Store(reflect.TypeFor[T_Params](), bind)
+}
+
+func RegisterBodyDecoder[T_Body any](decode func(data []byte, body *T_Body) error) {
+	bodyDecoders.Store(reflect.TypeFor[T_Body](), decode)
+}
+
+func RegisterResponseEncoder[T_Response any](encode func(dst []byte, response *T_Response) ([]byte, error)) {
+	responseEncoders.Store(reflect.TypeFor[T_Response](), encode)
+}
+
+func getParamsBinder[T_Params any]() func(*http.Request, *T_Params) error {
+	bind, ok := paramsBinders.Load(reflect.TypeFor[T_Params]())
+	if !ok {
+		return nil
+	}
+
+	return bind.(func(*http.Request, *T_Params) error)
+}
+
+func getBodyDecoder[T_Body any]() func([]byte, *T_Body) error {
+	decode, ok := bodyDecoders.Load(reflect.TypeFor[T_Body]())
+	if !ok {
+		return nil
+	}
+
+	return decode.(func([]byte, *T_Body) error)
+}
+
+func getResponseEncoder[T_Response any]() func([]byte, *T_Response) ([]byte, error) {
+	encode, ok := responseEncoders.Load(reflect.TypeFor[T_Response]())
+	if !ok {
+		return nil
+	}
+
+	return encode.(func([]byte, *T_Response) ([]byte, error))
+}
+
+func VerifyParamsBinder[T_Params any](requests ...*http.Request) error {
+	bind := getParamsBinder[T_Params]()
+	if bind == nil {
+		return fmt.Errorf("expected generated params binder for %v, received none", reflect.TypeFor[T_Params]())
+	}
+
+	plan := newParamsPlan(reflect.TypeFor[T_Params]())
+	for _, request := range requests {
+		var generated, reflected T_Params
+		generatedErr := bind(request, &generated)
+		reflectedErr := plan.bind(request, reflect.ValueOf(&reflected).Elem())
+
+		if !reflect.DeepEqual(generated, reflected) {
+			return fmt.Errorf("generated params binder for %v differs for %v: %+v, expected: %+v", reflect.TypeFor[T_Params](), request.URL, generated, reflected)
+		}
+
+		if fmt.Sprint(generatedErr) != fmt.Sprint(reflectedErr) {
+			return fmt.Errorf("generated params binder for %v differs for %v: error %v, expected: %v", reflect.TypeFor[T_Params](), request.URL, generatedErr, reflectedErr)
+		}
+	}
+
+	return nil
+}
+
+func VerifyBodyDecoder[T_Body any](bodies ...[]byte) error {
+	decode := getBodyDecoder[T_Body]()
+	if decode == nil {
+		return fmt.Errorf("expected generated body decoder for %v, received none", reflect.TypeFor[T_Body]())
+	}
+
+	for _, body := range bodies {
+		var generated, reflected T_Body
+		generatedErr := decode(body, &generated)
+		reflectedErr := decodeJSONBody(body, &reflected)
+
+		if (generatedErr == nil) != (reflectedErr == nil) {
+			return fmt.Errorf("generated body decoder for %v differs for %s: error %v, expected: %v", reflect.TypeFor[T_Body](), body, generatedErr, reflectedErr)
+		}
+
+		if generatedErr == nil && !reflect.DeepEqual(generated, reflected) {
+			return fmt.Errorf("generated body decoder for %v differs for %s: %+v, expected: %+v", reflect.TypeFor[T_Body](), body, generated, reflected)
+		}
+	}
+
+	return nil
+}
+
+func VerifyResponseEncoder[T_Response any](responses ...T_Response) error {
+	encode := getResponseEncoder[T_Response]()
+	if encode == nil {
+		return fmt.Errorf("expected generated response encoder for %v, received none", reflect.TypeFor[T_Response]())
+	}
+
+	for _, response := range responses {
+		generated, generatedErr := encode(nil, &response)
+
+		var reflected bytes.Buffer
+		reflectedErr := json.NewEncoder(&reflected).Encode(response)
+
+		if (generatedErr == nil) != (reflectedErr == nil) {
+			return fmt.Errorf("generated response encoder for %v differs for %+v: error %v, expected: %v", reflect.TypeFor[T_Response](), response, generatedErr, reflectedErr)
+		}
+
+		if generatedErr == nil && !bytes.Equal(append(generated, '\n'), reflected.Bytes()) {
+			return fmt.Errorf("generated response encoder for %v differs: %s, expected: %s", reflect.TypeFor[T_Response](), generated, bytes.TrimSpace(reflected.Bytes()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,527 @@
+package zeal
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type GoClientOptions struct {
+	ZealMux     *ZealMux
+	PackageName string
+}
+
+func GenerateGoClient(options GoClientOptions) ([]byte, error) {
+	if err := options.ZealMux.Validate(); err != nil {
+		return nil, err
+	}
+
+	packageName := options.PackageName
+	if packageName == "" {
+		packageName = "client"
+	}
+
+	generator := &goClientGenerator{
+		imports:     map[string]string{},
+		importNames: map[string]bool{},
+		mirrors:     map[reflect.Type]string{},
+		mirrorNames: map[string]bool{},
+	}
+	for _, name := range goClientReservedNames {
+		generator.mirrorNames[name] = true
+	}
+	for _, path := range goClientImports {
+		generator.importName(path, path[strings.LastIndex(path, "/")+1:])
+	}
+
+	var methods bytes.Buffer
+	methodNames := map[string]bool{}
+	for _, info := range options.ZealMux.Routes() {
+		name := getGoClientMethodName(info)
+		for i := 2; methodNames[name]; i++ {
+			name = fmt.Sprintf("%v%d", getGoClientMethodName(info), i)
+		}
+		methodNames[name] = true
+
+		generator.writeMethod(&methods, name, info)
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by zeal. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %v\n\n", packageName)
+
+	paths := make([]string, 0, len(generator.imports))
+	for path := range generator.imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	source.WriteString("import (\n")
+	for _, path := range paths {
+		if generator.imports[path] == path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(&source, "%q\n", path)
+		} else {
+			fmt.Fprintf(&source, "%v %q\n", generator.imports[path], path)
+		}
+	}
+	source.WriteString(")\n\n")
+
+	source.WriteString(goClientRuntime)
+	source.Write(generator.types.Bytes())
+	source.Write(methods.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format Go client: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// Routes returns the routes registered on the mux, its groups and nested muxes, sorted by path and method
+func (m *ZealMux) Routes() []RouteInfo {
+	m.spec.mu.RLock()
+	defer m.spec.mu.RUnlock()
+
+	return m.getRouteInfos()
+}
+
+func (m *ZealMux) getRouteInfos() []RouteInfo {
+	keys := make([]string, 0, len(m.routeInfos))
+	for key := range m.routeInfos {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		aMethod, aPath, _ := strings.Cut(a, " ")
+		bMethod, bPath, _ := strings.Cut(b, " ")
+		if aPath != bPath {
+			return strings.Compare(aPath, bPath)
+		}
+		return strings.Compare(aMethod, bMethod)
+	})
+
+	infos := make([]RouteInfo, len(keys))
+	for i, key := range keys {
+		infos[i] = m.routeInfos[key]
+	}
+
+	return infos
+}
+
+var goClientImports = []string{"bytes", "context", "encoding/json", "errors", "fmt", "io", "net/http", "net/url", "time"}
+
+var goClientReservedNames = []string{"Client", "NewClient", "Option", "WithHTTPClient", "WithRetry", "RetryPolicy", "Error"}
+
+const goClientRuntime = `type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	retry      RetryPolicy
+}
+
+type Option func(*Client)
+
+// RetryPolicy retries idempotent requests which fail with a network error or a 429, 502, 503 or 504 status.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+func NewClient(baseURL string, options ...Option) *Client {
+	client := &Client{baseURL: baseURL, httpClient: http.DefaultClient}
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+func WithRetry(retry RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = retry
+	}
+}
+
+type Error struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, bytes.TrimSpace(e.Body))
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body any, response any) error {
+	var requestBody []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = encoded
+	}
+
+	backoff := c.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		err := c.send(ctx, method, path, query, requestBody, body != nil, response)
+		if err == nil || attempt >= c.retry.MaxAttempts || !isRetryable(method, err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if c.retry.MaxBackoff > 0 && backoff > c.retry.MaxBackoff {
+			backoff = c.retry.MaxBackoff
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, requestBody []byte, hasBody bool, response any) error {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if hasBody {
+		bodyReader = bytes.NewReader(requestBody)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, target, bodyReader)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	if hasBody {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errorBody, _ := io.ReadAll(resp.Body)
+		return &Error{StatusCode: resp.StatusCode, Body: errorBody}
+	}
+
+	if response == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+func isRetryable(method string, err error) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions, http.MethodTrace:
+	default:
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	statusErr, ok := err.(*Error)
+	if !ok {
+		return true
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+`
+
+type goClientGenerator struct {
+	imports     map[string]string
+	importNames map[string]bool
+	mirrors     map[reflect.Type]string
+	mirrorNames map[string]bool
+	types       bytes.Buffer
+}
+
+func (g *goClientGenerator) writeMethod(methods *bytes.Buffer, name string, info RouteInfo) {
+	var arguments []string
+	arguments = append(arguments, "ctx context.Context")
+
+	if info.ParamsType != nil {
+		arguments = append(arguments, "params "+g.typeName(info.ParamsType, name+"Params"))
+	}
+
+	if info.BodyType != nil {
+		arguments = append(arguments, "body "+g.typeName(info.BodyType, name+"Body"))
+	}
+
+	var responseType string
+	if info.ResponseType != nil {
+		responseType = g.typeName(info.ResponseType, name+"Response")
+	}
+
+	comment := fmt.Sprintf("// %v calls %v %v", name, info.Method, getDocumentedPath(info.Path))
+	fmt.Fprintf(methods, "%v\n", comment)
+	if responseType != "" {
+		fmt.Fprintf(methods, "func (c *Client) %v(%v) (%v, error) {\nvar response %v\n", name, strings.Join(arguments, ", "), responseType, responseType)
+	} else {
+		fmt.Fprintf(methods, "func (c *Client) %v(%v) error {\n", name, strings.Join(arguments, ", "))
+	}
+
+	pathParams := map[string]bool{}
+	var pathParts []string
+	var literal strings.Builder
+	for i, segment := range strings.Split(info.Path, "/") {
+		if i > 0 {
+			literal.WriteString("/")
+		}
+
+		wildcards := getPatternWildcards(segment)
+		if len(wildcards) == 0 || info.ParamsType == nil {
+			literal.WriteString(strings.TrimSuffix(segment, "{$}"))
+			continue
+		}
+
+		if literal.Len() > 0 {
+			pathParts = append(pathParts, strconv.Quote(literal.String()))
+			literal.Reset()
+		}
+
+		wildcard := wildcards[0]
+		pathParams[wildcard.name] = true
+		value := fmt.Sprintf("fmt.Sprint(params.%v)", wildcard.name)
+		if wildcard.isRest {
+			pathParts = append(pathParts, fmt.Sprintf("(&url.URL{Path: %v}).EscapedPath()", value))
+		} else {
+			pathParts = append(pathParts, fmt.Sprintf("url.PathEscape(%v)", value))
+		}
+	}
+
+	if literal.Len() > 0 || len(pathParts) == 0 {
+		pathParts = append(pathParts, strconv.Quote(literal.String()))
+	}
+	fmt.Fprintf(methods, "path := %v\n", strings.Join(pathParts, " + "))
+
+	queryArgument := "nil"
+	if info.ParamsType != nil && info.ParamsType.Kind() == reflect.Struct {
+		var queryParams []string
+		for i := 0; i < info.ParamsType.NumField(); i++ {
+			field := info.ParamsType.Field(i)
+			if field.IsExported() && !pathParams[field.Name] {
+				queryParams = append(queryParams, field.Name)
+			}
+		}
+
+		if len(queryParams) > 0 {
+			queryArgument = "query"
+			methods.WriteString("query := url.Values{}\n")
+			for _, queryParam := range queryParams {
+				fmt.Fprintf(methods, "query.Set(%q, fmt.Sprint(params.%v))\n", queryParam, queryParam)
+			}
+		}
+	}
+
+	bodyArgument := "nil"
+	if info.BodyType != nil {
+		bodyArgument = "body"
+	}
+
+	if responseType != "" {
+		fmt.Fprintf(methods, "err := c.do(ctx, %q, path, %v, %v, &response)\nreturn response, err\n}\n\n", info.Method, queryArgument, bodyArgument)
+	} else {
+		fmt.Fprintf(methods, "return c.do(ctx, %q, path, %v, %v, nil)\n}\n\n", info.Method, queryArgument, bodyArgument)
+	}
+}
+
+func getGoClientMethodName(info RouteInfo) string {
+	if info.OperationID != "" {
+		return toGoIdentifier(info.OperationID)
+	}
+
+	name := toGoIdentifier(strings.ToLower(info.Method))
+	for _, segment := range strings.Split(getDocumentedPath(info.Path), "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name += "By" + toGoIdentifier(strings.Trim(segment, "{}"))
+			continue
+		}
+
+		name += toGoIdentifier(segment)
+	}
+
+	return name
+}
+
+func toGoIdentifier(value string) string {
+	var identifier strings.Builder
+	upper := true
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		if identifier.Len() == 0 && unicode.IsDigit(r) {
+			identifier.WriteRune('X')
+		}
+		identifier.WriteRune(r)
+	}
+
+	return identifier.String()
+}
+
+func (g *goClientGenerator) typeName(t reflect.Type, mirrorName string) string {
+	if t.Name() == "" && t.Kind() == reflect.Struct {
+		return g.mirror(t, mirrorName)
+	}
+
+	return g.goType(t)
+}
+
+func (g *goClientGenerator) goType(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+
+		if isImportable(t) {
+			packageName, _, _ := strings.Cut(t.String(), ".")
+			return g.importName(t.PkgPath(), packageName) + "." + t.Name()
+		}
+
+		return g.mirror(t, toGoIdentifier(t.Name()))
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + g.goType(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.goType(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%v", t.Len(), g.goType(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%v]%v", g.goType(t.Key()), g.goType(t.Elem()))
+	case reflect.Struct:
+		return g.structType(t)
+	default:
+		return "any"
+	}
+}
+
+func isImportable(t reflect.Type) bool {
+	pkgPath := t.PkgPath()
+	return pkgPath != "main" && token.IsExported(t.Name()) && !strings.Contains(t.Name(), "[") &&
+		!strings.Contains(pkgPath, "/internal/") && !strings.HasSuffix(pkgPath, "/internal") && !strings.HasPrefix(pkgPath, "internal/")
+}
+
+func (g *goClientGenerator) mirror(t reflect.Type, name string) string {
+	if mirrorName, ok := g.mirrors[t]; ok {
+		return mirrorName
+	}
+
+	mirrorName := name
+	for i := 2; g.mirrorNames[mirrorName]; i++ {
+		mirrorName = fmt.Sprintf("%v%d", name, i)
+	}
+	g.mirrorNames[mirrorName] = true
+	g.mirrors[t] = mirrorName
+
+	var underlying string
+	switch t.Kind() {
+	case reflect.Struct:
+		underlying = g.structType(t)
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		underlying = g.underlyingType(t)
+	case reflect.Interface:
+		underlying = "any"
+	default:
+		underlying = t.Kind().String()
+	}
+
+	fmt.Fprintf(&g.types, "type %v %v\n\n", mirrorName, underlying)
+
+	return mirrorName
+}
+
+func (g *goClientGenerator) underlyingType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + g.goType(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.goType(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%v", t.Len(), g.goType(t.Elem()))
+	default:
+		return fmt.Sprintf("map[%v]%v", g.goType(t.Key()), g.goType(t.Elem()))
+	}
+}
+
+func (g *goClientGenerator) structType(t reflect.Type) string {
+	var fields strings.Builder
+	fields.WriteString("struct {\n")
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Anonymous {
+			fields.WriteString(g.goType(field.Type))
+		} else {
+			fmt.Fprintf(&fields, "%v %v", field.Name, g.goType(field.Type))
+		}
+
+		if field.Tag != "" {
+			fmt.Fprintf(&fields, " `%v`", field.Tag)
+		}
+		fields.WriteString("\n")
+	}
+	fields.WriteString("}")
+
+	return fields.String()
+}
+
+func (g *goClientGenerator) importName(path, name string) string {
+	if importName, ok := g.imports[path]; ok {
+		return importName
+	}
+
+	importName := name
+	for i := 2; g.importNames[importName]; i++ {
+		importName = fmt.Sprintf("%v%d", name, i)
+	}
+
+	g.imports[path] = importName
+	g.importNames[importName] = true
+
+	return importName
+}
@@ -0,0 +1,63 @@
+package zeal
+
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type GroupOptions struct {
+	Tags       []string
+	Middleware []Middleware
+	Security   openapi3.SecurityRequirements
+}
+
+func (m *ZealMux) Group(prefix string, options ...GroupOptions) *ZealMux {
+	group := &ZealMux{
+		ServeMux:         m.ServeMux,
+		Api:              m.Api,
+		customOperations: m.customOperations,
+		securitySchemes:  m.securitySchemes,
+		routes:           m.routes,
+		routeInfos:       m.routeInfos,
+		spec:             m.spec,
+		errs:             m.errs,
+		prefix:           m.prefix + strings.TrimSuffix(prefix, "/"),
+		parent:           m,
+	}
+
+	if len(options) > 0 {
+		group.tags = options[0].Tags
+		group.middlewares = options[0].Middleware
+		group.security = options[0].Security
+	}
+
+	return group
+}
+
+func (m *ZealMux) prefixPattern(pattern string) string {
+	if m.prefix == "" {
+		return pattern
+	}
+
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		return m.prefix + pattern
+	}
+
+	return method + " " + m.prefix + path
+}
+
+func (m *ZealMux) getTags() []string {
+	var tags []string
+	for mux := m; mux != nil; mux = mux.parent {
+		tags = append(append([]string{}, mux.tags...), tags...)
+	}
+
+	return tags
+}
+
+func (m *ZealMux) customizeTags(operation *openapi3.Operation) error {
+	operation.Tags = append(m.getTags(), operation.Tags...)
+	return nil
+}
@@ -1,91 +1,98 @@
 package zeal
 
 import (
+	"errors"
 	"net/http"
 	"reflect"
 	"strings"
 )
 
 func (mux *Route) HandleFunc(pattern string, handlerFunc http.HandlerFunc) {
-	routeValue := defineRoute(mux, pattern)
-	wrapped := wrapHandlerFunc(routeValue, handlerFunc)
-	mux.ZealMux.HandleFunc(pattern, wrapped)
+	pattern = mux.ZealMux.prefixPattern(pattern)
+	plan, info, err := defineRoute(mux, pattern)
+	if err != nil {
+		panic(err)
+	}
+	wrapped := wrapHandlerFunc(mux, info, plan, handlerFunc)
+	mux.ZealMux.ServeMux.Handle(pattern, mux.applyMiddleware(info, wrapped))
 }
 
-func wrapHandlerFunc(routeValue reflect.Value, handlerFunc http.HandlerFunc) func(w http.ResponseWriter, r *http.Request) {
+func wrapHandlerFunc(route *Route, info RouteInfo, plan bindingPlan, handlerFunc http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		err := initRoute(routeValue, w, r)
-		if err != nil {
-			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
-			return
+		checked, check := route.checkContract(info, w, r)
+		if r, ok := bindRequest(route, plan, checked, r); ok {
+			handlerFunc(checked, r)
 		}
+		check()
+	}
+}
 
-		handlerFunc(w, r)
+// bindRequest authenticates and binds the request, writing the error response if either fails
+func bindRequest(route *Route, plan bindingPlan, w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	r, err := route.authenticate(r)
+	if err != nil {
+		writeAuthError(w, err)
+		return r, false
 	}
+
+	err = plan.bind(w, r)
+	if errors.Is(err, ErrUnauthenticated) {
+		writeAuthError(w, err)
+		return r, false
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
+		return r, false
+	}
+
+	return r, true
 }
 
 type HandlerFuncErr func(http.ResponseWriter, *http.Request) error
 
 func (mux *Route) HandleFuncErr(pattern string, handlerFunc HandlerFuncErr) {
-	routeValue := defineRoute(mux, pattern)
-	wrapped := wrapHandlerFuncErr(routeValue, handlerFunc)
-	mux.ZealMux.HandleFunc(pattern, wrapped)
+	pattern = mux.ZealMux.prefixPattern(pattern)
+	plan, info, err := defineRoute(mux, pattern)
+	if err != nil {
+		panic(err)
+	}
+	wrapped := wrapHandlerFuncErr(mux, info, plan, handlerFunc)
+	mux.ZealMux.ServeMux.Handle(pattern, mux.applyMiddleware(info, wrapped))
 }
 
-func wrapHandlerFuncErr(routeValue reflect.Value, handlerFunc HandlerFuncErr) func(w http.ResponseWriter, r *http.Request) {
+func wrapHandlerFuncErr(route *Route, info RouteInfo, plan bindingPlan, handlerFunc HandlerFuncErr) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		err := initRoute(routeValue, w, r)
-		if err != nil {
-			http.Error(w, http.StatusText(http.StatusUnprocessableEntity), http.StatusUnprocessableEntity)
-			return
+		checked, check := route.checkContract(info, w, r)
+		if r, ok := bindRequest(route, plan, checked, r); ok {
+			handlerFunc(checked, r)
 		}
-
-		handlerFunc(w, r)
+		check()
 	}
 }
 
-func defineRoute(route *Route, pattern string) reflect.Value {
+func defineRoute(route *Route, pattern string) (bindingPlan, RouteInfo, error) {
 	routeValues := reflect.ValueOf(route).MethodByName("Validate").Call([]reflect.Value{})
 	routeValue := routeValues[0].Elem().Elem().Elem()
-	registerRoute(route.ZealMux, pattern, routeValue)
-	return routeValue
-}
-
-func initRoute(routeValue reflect.Value, w http.ResponseWriter, r *http.Request) error {
-	if routeValue.Kind() == reflect.Interface {
-		return nil
-	}
+	info := newRouteInfo(route, pattern, routeValue)
 
-	paramsTypeName := getTypeName(HasParams[any]{})
-	paramsValue := routeValue.FieldByName(paramsTypeName)
-	if paramsValue.IsValid() {
-		validateParams := paramsValue.Addr().MethodByName("Validate")
-		paramsAndParamsErr := validateParams.Call([]reflect.Value{reflect.ValueOf(r)})
-		err := paramsAndParamsErr[1].Interface()
-		if err != nil {
-			return err.(error)
+	var err error
+	route.ZealMux.updateRegistry(func() {
+		if err = route.ZealMux.claimRoute(info.Method, info.Path, info.Pattern); err != nil {
+			route.ZealMux.addError(err)
+			return
 		}
-	}
 
-	bodyTypeName := getTypeName(HasBody[any]{})
-	bodyValue := routeValue.FieldByName(bodyTypeName)
-	if bodyValue.IsValid() {
-		validateBody := bodyValue.Addr().MethodByName("Validate")
-		bodyAndBodyErr := validateBody.Call([]reflect.Value{reflect.ValueOf(r)})
-		err := bodyAndBodyErr[1].Interface()
-		if err != nil {
-			return err.(error)
+		if err := checkRoute(info); err != nil {
+			route.ZealMux.addError(err)
 		}
-	}
 
-	responseTypeName := getTypeName(HasResponse[any]{})
-	responseValue := routeValue.FieldByName(responseTypeName)
-	if responseValue.IsValid() {
-		validateResponse := responseValue.Addr().MethodByName("Validate")
-		validateResponse.Call([]reflect.Value{reflect.ValueOf(&w)})
+		registerRoute(route, info)
+	})
+	if err != nil {
+		return bindingPlan{}, info, err
 	}
 
-	return nil
+	return newBindingPlan(routeValue), info, nil
 }
 
 func getTypeName(instance any) string {
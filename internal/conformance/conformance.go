@@ -0,0 +1,65 @@
+// Package conformance holds route definitions covering the types zeal gen binders supports,
+// so that its tests can check generated code against the reflection path
+package conformance
+
+import "github.com/DandyCodes/zeal"
+
+//go:generate go run ../../cmd/zeal gen binders
+
+type Label string
+
+type Level int8
+
+type Params struct {
+	ID      int `in:"path"`
+	Name    string
+	Label   Label
+	Level   Level
+	Small   int8
+	Medium  int16
+	Large   int64
+	Count   uint
+	Byte    uint8
+	Ratio   float32
+	Price   float64
+	Enabled bool
+}
+
+type Item struct {
+	Name     string            `json:"name"`
+	Price    float64           `json:"price,omitempty"`
+	Weight   float32           `json:"weight"`
+	Quantity uint16            `json:"quantity,omitempty"`
+	Level    Level             `json:"level"`
+	Label    Label             `json:"label,omitempty"`
+	Tags     []string          `json:"tags"`
+	Scores   [3]int            `json:"scores"`
+	Data     []byte            `json:"data,omitempty"`
+	Extra    map[string]int    `json:"extra"`
+	Labels   map[Label]string  `json:"labels,omitempty"`
+	Parent   *Item             `json:"parent,omitempty"`
+	Children []Item            `json:"children"`
+	Optional *string           `json:"optional"`
+	Nested   map[string][]bool `json:"nested,omitempty"`
+	Ignored  string            `json:"-"`
+	Untagged int
+	internal int
+}
+
+type ParamsRoute struct {
+	zeal.Route
+	zeal.HasParams[Params]
+	zeal.HasResponse[Item]
+}
+
+type BodyRoute struct {
+	zeal.Route
+	zeal.HasBody[Item]
+	zeal.HasResponse[[]Item]
+}
+
+type MapBodyRoute struct {
+	zeal.Route
+	zeal.HasBody[map[string]*Item]
+	zeal.HasResponse[map[Label]Level]
+}
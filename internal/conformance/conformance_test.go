@@ -0,0 +1,147 @@
+package conformance
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+)
+
+func TestParamsBinder(t *testing.T) {
+	targets := []string{
+		"/items",
+		"/items?Name=pizza&Label=hot&Level=-3&Small=127&Medium=-32768&Large=9223372036854775807",
+		"/items?Count=18446744073709551615&Byte=255&Ratio=1.5&Price=-0.25&Enabled=true",
+		"/items?Small=128",
+		"/items?Level=-129",
+		"/items?Byte=256",
+		"/items?Count=-1",
+		"/items?Ratio=1e39",
+		"/items?Price=abc&Enabled=yes",
+		"/items?Name=a%20b&Name=ignored",
+	}
+
+	var requests []*http.Request
+	for _, target := range targets {
+		requests = append(requests, httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	withPath := httptest.NewRequest(http.MethodGet, "/items/42?ID=7", nil)
+	withPath.SetPathValue("ID", "42")
+	requests = append(requests, withPath)
+
+	if err := zeal.VerifyParamsBinder[Params](requests...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBodyDecoder(t *testing.T) {
+	bodies := []string{
+		``,
+		`   `,
+		`null`,
+		`{}`,
+		` {"name":"pizza","price":12.5,"weight":0.25,"quantity":3,"level":-2,"label":"hot"} `,
+		`{"tags":["a","b"],"scores":[1,2],"data":"aGVsbG8=","extra":{"x":1},"labels":{"l":"v"}}`,
+		`{"scores":[1,2,3,4,5]}`,
+		`{"scores":null,"tags":null,"extra":null,"parent":null,"optional":null}`,
+		`{"tags":[],"children":[],"extra":{},"data":""}`,
+		`{"data":[104,105]}`,
+		`{"data":"not base64!"}`,
+		`{"parent":{"name":"base","children":[{"name":"child"}]},"optional":"set"}`,
+		`{"nested":{"a":[true,false,null]}}`,
+		`{"NAME":"upper","Untagged":1,"untagged":2}`,
+		`{"name":"first","name":"second"}`,
+		`{"name":"é😀\n\"quoted\""}`,
+		"{\"name\":\"\xff invalid\"}",
+		`{"Ignored":"x"}`,
+		`{"internal":1}`,
+		`{"unknown":1}`,
+		`{"name":1}`,
+		`{"price":"1"}`,
+		`{"quantity":70000}`,
+		`{"quantity":-1}`,
+		`{"level":128}`,
+		`{"level":1.5}`,
+		`{"weight":1e39}`,
+		`{"price":1e308,"weight":-0}`,
+		`{"price":-}`,
+		`{"price":01}`,
+		`{"price":1.}`,
+		`{"price":1e}`,
+		`{"name":"pizza"`,
+		`{"name":"pizza",}`,
+		`{"name" "pizza"}`,
+		`{"tags":["a",]}`,
+		`{"scores":[1,2,3,"x"]}`,
+		`[]`,
+		`"item"`,
+		`{"name":"pizza"} trailing`,
+		`{"name":"pizza"}{"name":"second"}`,
+		`{"label":"\u00"}`,
+		"{\"name\":\"tab\there\"}",
+	}
+
+	var data [][]byte
+	for _, body := range bodies {
+		data = append(data, []byte(body))
+	}
+
+	if err := zeal.VerifyBodyDecoder[Item](data...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := zeal.VerifyBodyDecoder[map[string]*Item](
+		[]byte(`{"a":{"name":"x"},"b":null}`),
+		[]byte(`{"a":{"unknown":true}}`),
+		[]byte(`{"a":1}`),
+		[]byte(`null`),
+		[]byte(`{}`),
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResponseEncoder(t *testing.T) {
+	optional := "set"
+	items := []Item{
+		{},
+		{
+			Name:     "<pizza> & \"quotes\"   \x01",
+			Price:    12.5,
+			Weight:   1e-7,
+			Quantity: 3,
+			Level:    -2,
+			Label:    "hot",
+			Tags:     []string{"a", "b"},
+			Scores:   [3]int{1, 2, 3},
+			Data:     []byte("hello"),
+			Extra:    map[string]int{"b": 2, "a": 1},
+			Labels:   map[Label]string{"l": "v"},
+			Parent:   &Item{Name: "parent"},
+			Children: []Item{{Name: "child"}},
+			Optional: &optional,
+			Nested:   map[string][]bool{"x": {true, false}},
+			Ignored:  "ignored",
+			Untagged: 7,
+			internal: 1,
+		},
+		{Name: "\xff invalid", Price: 1e21, Weight: float32(math.MaxFloat32), Tags: []string{}, Extra: map[string]int{}},
+		{Price: math.Inf(1)},
+		{Weight: float32(math.NaN())},
+	}
+
+	if err := zeal.VerifyResponseEncoder(items...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := zeal.VerifyResponseEncoder([]Item{}, nil, items[:2]); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := zeal.VerifyResponseEncoder(map[Label]Level{}, nil, map[Label]Level{"z": 1, "a": -1}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,1609 @@
+// Code generated by zeal gen binders. DO NOT EDIT.
+
+package conformance
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DandyCodes/zeal"
+)
+
+func init() {
+	zeal.RegisterParamsBinder(zealBindParams0)
+	zeal.RegisterResponseEncoder(zealAppendJSONItem)
+	zeal.RegisterBodyDecoder(func(data []byte, body *Item) error {
+		return zealJSONDecodeBody(data, body, zealDecodeJSONItem)
+	})
+	zeal.RegisterResponseEncoder(zealAppendJSON6)
+	zeal.RegisterBodyDecoder(func(data []byte, body *map[string]*Item) error {
+		return zealJSONDecodeBody(data, body, zealDecodeJSON10)
+	})
+	zeal.RegisterResponseEncoder(zealAppendJSON10)
+}
+
+func zealBindParams0(r *http.Request, params *Params) error {
+	var query url.Values
+	var err error
+	var rawValue string
+
+	rawValue = r.PathValue("ID")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("ID")
+	}
+	if val, parseErr := strconv.ParseInt(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse integer from: %v", rawValue)
+	} else if val < math.MinInt || val > math.MaxInt {
+		err = fmt.Errorf("value out of range for int: %d", val)
+	} else {
+		params.ID = int(val)
+	}
+
+	rawValue = r.PathValue("Name")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Name")
+	}
+	params.Name = string(rawValue)
+
+	rawValue = r.PathValue("Label")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Label")
+	}
+	params.Label = Label(rawValue)
+
+	rawValue = r.PathValue("Level")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Level")
+	}
+	if val, parseErr := strconv.ParseInt(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse integer from: %v", rawValue)
+	} else if val < math.MinInt8 || val > math.MaxInt8 {
+		err = fmt.Errorf("value out of range for int8: %d", val)
+	} else {
+		params.Level = Level(val)
+	}
+
+	rawValue = r.PathValue("Small")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Small")
+	}
+	if val, parseErr := strconv.ParseInt(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse integer from: %v", rawValue)
+	} else if val < math.MinInt8 || val > math.MaxInt8 {
+		err = fmt.Errorf("value out of range for int8: %d", val)
+	} else {
+		params.Small = int8(val)
+	}
+
+	rawValue = r.PathValue("Medium")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Medium")
+	}
+	if val, parseErr := strconv.ParseInt(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse integer from: %v", rawValue)
+	} else if val < math.MinInt16 || val > math.MaxInt16 {
+		err = fmt.Errorf("value out of range for int16: %d", val)
+	} else {
+		params.Medium = int16(val)
+	}
+
+	rawValue = r.PathValue("Large")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Large")
+	}
+	if val, parseErr := strconv.ParseInt(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse integer from: %v", rawValue)
+	} else {
+		params.Large = int64(val)
+	}
+
+	rawValue = r.PathValue("Count")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Count")
+	}
+	if val, parseErr := strconv.ParseUint(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse unsigned integer from: %v", rawValue)
+	} else if val > math.MaxUint {
+		err = fmt.Errorf("value out of range for uint: %d", val)
+	} else {
+		params.Count = uint(val)
+	}
+
+	rawValue = r.PathValue("Byte")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Byte")
+	}
+	if val, parseErr := strconv.ParseUint(rawValue, 10, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse unsigned integer from: %v", rawValue)
+	} else if val > math.MaxUint8 {
+		err = fmt.Errorf("value out of range for uint8: %d", val)
+	} else {
+		params.Byte = uint8(val)
+	}
+
+	rawValue = r.PathValue("Ratio")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Ratio")
+	}
+	if val, parseErr := strconv.ParseFloat(rawValue, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse float from: %v", rawValue)
+	} else if !(val >= -math.MaxFloat32 && val <= math.MaxFloat32) {
+		err = fmt.Errorf("value out of range for float32: %v", val)
+	} else {
+		params.Ratio = float32(val)
+	}
+
+	rawValue = r.PathValue("Price")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Price")
+	}
+	if val, parseErr := strconv.ParseFloat(rawValue, 64); parseErr != nil {
+		err = fmt.Errorf("failed to parse float from: %v", rawValue)
+	} else {
+		params.Price = float64(val)
+	}
+
+	rawValue = r.PathValue("Enabled")
+	if rawValue == "" {
+		if query == nil {
+			query = r.URL.Query()
+		}
+		rawValue = query.Get("Enabled")
+	}
+	if val, parseErr := strconv.ParseBool(rawValue); parseErr != nil {
+		err = fmt.Errorf("failed to parse boolean from: %v", rawValue)
+	} else {
+		params.Enabled = bool(val)
+	}
+
+	return err
+}
+
+func zealAppendJSONString(dst []byte, v *string) ([]byte, error) {
+	return zealJSONAppendString(dst, string(*v)), nil
+}
+
+func zealAppendJSONFloat64(dst []byte, v *float64) ([]byte, error) {
+	return zealJSONAppendFloat(dst, float64(*v), 64)
+}
+
+func zealAppendJSONFloat32(dst []byte, v *float32) ([]byte, error) {
+	return zealJSONAppendFloat(dst, float64(*v), 32)
+}
+
+func zealAppendJSONUint16(dst []byte, v *uint16) ([]byte, error) {
+	return strconv.AppendUint(dst, uint64(*v), 10), nil
+}
+
+func zealAppendJSONLevel(dst []byte, v *Level) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(*v), 10), nil
+}
+
+func zealAppendJSONLabel(dst []byte, v *Label) ([]byte, error) {
+	return zealJSONAppendString(dst, string(*v)), nil
+}
+
+func zealAppendJSON0(dst []byte, v *[]string) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	dst = append(dst, '[')
+	for i := range *v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		var err error
+		if dst, err = zealAppendJSONString(dst, &(*v)[i]); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, ']'), nil
+}
+
+func zealAppendJSONInt(dst []byte, v *int) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(*v), 10), nil
+}
+
+func zealAppendJSON1(dst []byte, v *[3]int) ([]byte, error) {
+	dst = append(dst, '[')
+	for i := range *v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		var err error
+		if dst, err = zealAppendJSONInt(dst, &(*v)[i]); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, ']'), nil
+}
+
+func zealAppendJSON2(dst []byte, v *[]byte) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	dst = append(dst, '"')
+	dst = base64.StdEncoding.AppendEncode(dst, *v)
+
+	return append(dst, '"'), nil
+}
+
+func zealAppendJSON3(dst []byte, v *map[string]int) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	keys := make([]string, 0, len(*v))
+	for key := range *v {
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+
+	dst = append(dst, '{')
+	for i, key := range keys {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = zealJSONAppendString(dst, key)
+		dst = append(dst, ':')
+		value := (*v)[string(key)]
+		var err error
+		if dst, err = zealAppendJSONInt(dst, &value); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, '}'), nil
+}
+
+func zealAppendJSON4(dst []byte, v *map[Label]string) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	keys := make([]string, 0, len(*v))
+	for key := range *v {
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+
+	dst = append(dst, '{')
+	for i, key := range keys {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = zealJSONAppendString(dst, key)
+		dst = append(dst, ':')
+		value := (*v)[Label(key)]
+		var err error
+		if dst, err = zealAppendJSONString(dst, &value); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, '}'), nil
+}
+
+func zealAppendJSON5(dst []byte, v **Item) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	return zealAppendJSONItem(dst, *v)
+}
+
+func zealAppendJSON6(dst []byte, v *[]Item) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	dst = append(dst, '[')
+	for i := range *v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		var err error
+		if dst, err = zealAppendJSONItem(dst, &(*v)[i]); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, ']'), nil
+}
+
+func zealAppendJSON7(dst []byte, v **string) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	return zealAppendJSONString(dst, *v)
+}
+
+func zealAppendJSONBool(dst []byte, v *bool) ([]byte, error) {
+	if *v {
+		return append(dst, "true"...), nil
+	}
+
+	return append(dst, "false"...), nil
+}
+
+func zealAppendJSON9(dst []byte, v *[]bool) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	dst = append(dst, '[')
+	for i := range *v {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		var err error
+		if dst, err = zealAppendJSONBool(dst, &(*v)[i]); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, ']'), nil
+}
+
+func zealAppendJSON8(dst []byte, v *map[string][]bool) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	keys := make([]string, 0, len(*v))
+	for key := range *v {
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+
+	dst = append(dst, '{')
+	for i, key := range keys {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = zealJSONAppendString(dst, key)
+		dst = append(dst, ':')
+		value := (*v)[string(key)]
+		var err error
+		if dst, err = zealAppendJSON9(dst, &value); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, '}'), nil
+}
+
+func zealAppendJSONItem(dst []byte, v *Item) ([]byte, error) {
+	dst = append(dst, '{')
+	var err error
+	comma := false
+
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"name\":"...)
+	if dst, err = zealAppendJSONString(dst, &v.Name); err != nil {
+		return dst, err
+	}
+	if v.Price != 0 {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"price\":"...)
+		if dst, err = zealAppendJSONFloat64(dst, &v.Price); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"weight\":"...)
+	if dst, err = zealAppendJSONFloat32(dst, &v.Weight); err != nil {
+		return dst, err
+	}
+	if v.Quantity != 0 {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"quantity\":"...)
+		if dst, err = zealAppendJSONUint16(dst, &v.Quantity); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"level\":"...)
+	if dst, err = zealAppendJSONLevel(dst, &v.Level); err != nil {
+		return dst, err
+	}
+	if v.Label != "" {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"label\":"...)
+		if dst, err = zealAppendJSONLabel(dst, &v.Label); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"tags\":"...)
+	if dst, err = zealAppendJSON0(dst, &v.Tags); err != nil {
+		return dst, err
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"scores\":"...)
+	if dst, err = zealAppendJSON1(dst, &v.Scores); err != nil {
+		return dst, err
+	}
+	if len(v.Data) != 0 {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"data\":"...)
+		if dst, err = zealAppendJSON2(dst, &v.Data); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"extra\":"...)
+	if dst, err = zealAppendJSON3(dst, &v.Extra); err != nil {
+		return dst, err
+	}
+	if len(v.Labels) != 0 {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"labels\":"...)
+		if dst, err = zealAppendJSON4(dst, &v.Labels); err != nil {
+			return dst, err
+		}
+	}
+	if v.Parent != nil {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"parent\":"...)
+		if dst, err = zealAppendJSON5(dst, &v.Parent); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"children\":"...)
+	if dst, err = zealAppendJSON6(dst, &v.Children); err != nil {
+		return dst, err
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"optional\":"...)
+	if dst, err = zealAppendJSON7(dst, &v.Optional); err != nil {
+		return dst, err
+	}
+	if len(v.Nested) != 0 {
+		if comma {
+			dst = append(dst, ',')
+		}
+		comma = true
+		dst = append(dst, "\"nested\":"...)
+		if dst, err = zealAppendJSON8(dst, &v.Nested); err != nil {
+			return dst, err
+		}
+	}
+	if comma {
+		dst = append(dst, ',')
+	}
+	comma = true
+	dst = append(dst, "\"Untagged\":"...)
+	if dst, err = zealAppendJSONInt(dst, &v.Untagged); err != nil {
+		return dst, err
+	}
+
+	return append(dst, '}'), nil
+}
+
+func zealDecodeJSONString(d *zealJSONDecoder, v *string) error {
+	if d.isNull() {
+		return nil
+	}
+
+	val, err := d.readString()
+	if err != nil {
+		return err
+	}
+
+	*v = string(val)
+
+	return nil
+}
+
+func zealDecodeJSONFloat64(d *zealJSONDecoder, v *float64) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return err
+	}
+
+	*v = float64(val)
+
+	return nil
+}
+
+func zealDecodeJSONFloat32(d *zealJSONDecoder, v *float32) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseFloat(number, 32)
+	if err != nil {
+		return err
+	}
+
+	*v = float32(val)
+
+	return nil
+}
+
+func zealDecodeJSONUint16(d *zealJSONDecoder, v *uint16) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseUint(number, 10, 16)
+	if err != nil {
+		return err
+	}
+
+	*v = uint16(val)
+
+	return nil
+}
+
+func zealDecodeJSONLevel(d *zealJSONDecoder, v *Level) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseInt(number, 10, 8)
+	if err != nil {
+		return err
+	}
+
+	*v = Level(val)
+
+	return nil
+}
+
+func zealDecodeJSONLabel(d *zealJSONDecoder, v *Label) error {
+	if d.isNull() {
+		return nil
+	}
+
+	val, err := d.readString()
+	if err != nil {
+		return err
+	}
+
+	*v = Label(val)
+
+	return nil
+}
+
+func zealDecodeJSON0(d *zealJSONDecoder, v *[]string) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('[') {
+		return d.invalid()
+	}
+
+	i := 0
+	for first := true; ; first = false {
+		more, err := d.next(']', first)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
+		if i >= cap(*v) {
+			*v = slices.Grow(*v, 1)
+		}
+		if i >= len(*v) {
+			*v = (*v)[:i+1]
+		}
+		if err := zealDecodeJSONString(d, &(*v)[i]); err != nil {
+			return err
+		}
+		i++
+	}
+
+	if i < len(*v) {
+		*v = (*v)[:i]
+	}
+	if i == 0 {
+		*v = make([]string, 0)
+	}
+
+	return nil
+}
+
+func zealDecodeJSONInt(d *zealJSONDecoder, v *int) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseInt(number, 10, 0)
+	if err != nil {
+		return err
+	}
+
+	*v = int(val)
+
+	return nil
+}
+
+func zealDecodeJSON1(d *zealJSONDecoder, v *[3]int) error {
+	if d.isNull() {
+		return nil
+	}
+
+	if !d.consume('[') {
+		return d.invalid()
+	}
+
+	i := 0
+	for first := true; ; first = false {
+		more, err := d.next(']', first)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
+		if i < len(*v) {
+			err = zealDecodeJSONInt(d, &(*v)[i])
+		} else {
+			err = d.skipValue()
+		}
+		if err != nil {
+			return err
+		}
+		i++
+	}
+
+	if i < len(*v) {
+		clear((*v)[i:])
+	}
+
+	return nil
+}
+
+func zealDecodeJSONByte(d *zealJSONDecoder, v *byte) error {
+	if d.isNull() {
+		return nil
+	}
+
+	number, err := d.readNumber()
+	if err != nil {
+		return err
+	}
+
+	val, err := strconv.ParseUint(number, 10, 8)
+	if err != nil {
+		return err
+	}
+
+	*v = byte(val)
+
+	return nil
+}
+
+func zealDecodeJSON2(d *zealJSONDecoder, v *[]byte) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if d.peek() == '"' {
+		encoded, err := d.readString()
+		if err != nil {
+			return err
+		}
+
+		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+		n, err := base64.StdEncoding.Decode(decoded, []byte(encoded))
+		if err != nil {
+			return err
+		}
+
+		*v = decoded[:n]
+
+		return nil
+	}
+
+	if !d.consume('[') {
+		return d.invalid()
+	}
+
+	i := 0
+	for first := true; ; first = false {
+		more, err := d.next(']', first)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
+		if i >= cap(*v) {
+			*v = slices.Grow(*v, 1)
+		}
+		if i >= len(*v) {
+			*v = (*v)[:i+1]
+		}
+		if err := zealDecodeJSONByte(d, &(*v)[i]); err != nil {
+			return err
+		}
+		i++
+	}
+
+	if i < len(*v) {
+		*v = (*v)[:i]
+	}
+	if i == 0 {
+		*v = make([]byte, 0)
+	}
+
+	return nil
+}
+
+func zealDecodeJSON3(d *zealJSONDecoder, v *map[string]int) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('{') {
+		return d.invalid()
+	}
+
+	if *v == nil {
+		*v = make(map[string]int)
+	}
+
+	for first := true; ; first = false {
+		more, err := d.next('}', first)
+		if err != nil || !more {
+			return err
+		}
+
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		var value int
+		if err := zealDecodeJSONInt(d, &value); err != nil {
+			return err
+		}
+		(*v)[string(key)] = value
+	}
+}
+
+func zealDecodeJSON4(d *zealJSONDecoder, v *map[Label]string) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('{') {
+		return d.invalid()
+	}
+
+	if *v == nil {
+		*v = make(map[Label]string)
+	}
+
+	for first := true; ; first = false {
+		more, err := d.next('}', first)
+		if err != nil || !more {
+			return err
+		}
+
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		var value string
+		if err := zealDecodeJSONString(d, &value); err != nil {
+			return err
+		}
+		(*v)[Label(key)] = value
+	}
+}
+
+func zealDecodeJSON5(d *zealJSONDecoder, v **Item) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if *v == nil {
+		*v = new(Item)
+	}
+
+	return zealDecodeJSONItem(d, *v)
+}
+
+func zealDecodeJSON6(d *zealJSONDecoder, v *[]Item) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('[') {
+		return d.invalid()
+	}
+
+	i := 0
+	for first := true; ; first = false {
+		more, err := d.next(']', first)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
+		if i >= cap(*v) {
+			*v = slices.Grow(*v, 1)
+		}
+		if i >= len(*v) {
+			*v = (*v)[:i+1]
+		}
+		if err := zealDecodeJSONItem(d, &(*v)[i]); err != nil {
+			return err
+		}
+		i++
+	}
+
+	if i < len(*v) {
+		*v = (*v)[:i]
+	}
+	if i == 0 {
+		*v = make([]Item, 0)
+	}
+
+	return nil
+}
+
+func zealDecodeJSON7(d *zealJSONDecoder, v **string) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if *v == nil {
+		*v = new(string)
+	}
+
+	return zealDecodeJSONString(d, *v)
+}
+
+func zealDecodeJSONBool(d *zealJSONDecoder, v *bool) error {
+	if d.isNull() {
+		return nil
+	}
+
+	val, err := d.readBool()
+	if err != nil {
+		return err
+	}
+
+	*v = bool(val)
+
+	return nil
+}
+
+func zealDecodeJSON9(d *zealJSONDecoder, v *[]bool) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('[') {
+		return d.invalid()
+	}
+
+	i := 0
+	for first := true; ; first = false {
+		more, err := d.next(']', first)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
+		if i >= cap(*v) {
+			*v = slices.Grow(*v, 1)
+		}
+		if i >= len(*v) {
+			*v = (*v)[:i+1]
+		}
+		if err := zealDecodeJSONBool(d, &(*v)[i]); err != nil {
+			return err
+		}
+		i++
+	}
+
+	if i < len(*v) {
+		*v = (*v)[:i]
+	}
+	if i == 0 {
+		*v = make([]bool, 0)
+	}
+
+	return nil
+}
+
+func zealDecodeJSON8(d *zealJSONDecoder, v *map[string][]bool) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('{') {
+		return d.invalid()
+	}
+
+	if *v == nil {
+		*v = make(map[string][]bool)
+	}
+
+	for first := true; ; first = false {
+		more, err := d.next('}', first)
+		if err != nil || !more {
+			return err
+		}
+
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		var value []bool
+		if err := zealDecodeJSON9(d, &value); err != nil {
+			return err
+		}
+		(*v)[string(key)] = value
+	}
+}
+
+func zealDecodeJSONItem(d *zealJSONDecoder, v *Item) error {
+	if d.isNull() {
+		return nil
+	}
+
+	if !d.consume('{') {
+		return d.invalid()
+	}
+
+	for first := true; ; first = false {
+		more, err := d.next('}', first)
+		if err != nil || !more {
+			return err
+		}
+
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case key == "name":
+			err = zealDecodeJSONString(d, &v.Name)
+		case key == "price":
+			err = zealDecodeJSONFloat64(d, &v.Price)
+		case key == "weight":
+			err = zealDecodeJSONFloat32(d, &v.Weight)
+		case key == "quantity":
+			err = zealDecodeJSONUint16(d, &v.Quantity)
+		case key == "level":
+			err = zealDecodeJSONLevel(d, &v.Level)
+		case key == "label":
+			err = zealDecodeJSONLabel(d, &v.Label)
+		case key == "tags":
+			err = zealDecodeJSON0(d, &v.Tags)
+		case key == "scores":
+			err = zealDecodeJSON1(d, &v.Scores)
+		case key == "data":
+			err = zealDecodeJSON2(d, &v.Data)
+		case key == "extra":
+			err = zealDecodeJSON3(d, &v.Extra)
+		case key == "labels":
+			err = zealDecodeJSON4(d, &v.Labels)
+		case key == "parent":
+			err = zealDecodeJSON5(d, &v.Parent)
+		case key == "children":
+			err = zealDecodeJSON6(d, &v.Children)
+		case key == "optional":
+			err = zealDecodeJSON7(d, &v.Optional)
+		case key == "nested":
+			err = zealDecodeJSON8(d, &v.Nested)
+		case key == "Untagged":
+			err = zealDecodeJSONInt(d, &v.Untagged)
+		case strings.EqualFold(key, "name"):
+			err = zealDecodeJSONString(d, &v.Name)
+		case strings.EqualFold(key, "price"):
+			err = zealDecodeJSONFloat64(d, &v.Price)
+		case strings.EqualFold(key, "weight"):
+			err = zealDecodeJSONFloat32(d, &v.Weight)
+		case strings.EqualFold(key, "quantity"):
+			err = zealDecodeJSONUint16(d, &v.Quantity)
+		case strings.EqualFold(key, "level"):
+			err = zealDecodeJSONLevel(d, &v.Level)
+		case strings.EqualFold(key, "label"):
+			err = zealDecodeJSONLabel(d, &v.Label)
+		case strings.EqualFold(key, "tags"):
+			err = zealDecodeJSON0(d, &v.Tags)
+		case strings.EqualFold(key, "scores"):
+			err = zealDecodeJSON1(d, &v.Scores)
+		case strings.EqualFold(key, "data"):
+			err = zealDecodeJSON2(d, &v.Data)
+		case strings.EqualFold(key, "extra"):
+			err = zealDecodeJSON3(d, &v.Extra)
+		case strings.EqualFold(key, "labels"):
+			err = zealDecodeJSON4(d, &v.Labels)
+		case strings.EqualFold(key, "parent"):
+			err = zealDecodeJSON5(d, &v.Parent)
+		case strings.EqualFold(key, "children"):
+			err = zealDecodeJSON6(d, &v.Children)
+		case strings.EqualFold(key, "optional"):
+			err = zealDecodeJSON7(d, &v.Optional)
+		case strings.EqualFold(key, "nested"):
+			err = zealDecodeJSON8(d, &v.Nested)
+		case strings.EqualFold(key, "Untagged"):
+			err = zealDecodeJSONInt(d, &v.Untagged)
+		default:
+			err = d.unknownField(key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func zealDecodeJSON10(d *zealJSONDecoder, v *map[string]*Item) error {
+	if d.isNull() {
+		*v = nil
+		return nil
+	}
+
+	if !d.consume('{') {
+		return d.invalid()
+	}
+
+	if *v == nil {
+		*v = make(map[string]*Item)
+	}
+
+	for first := true; ; first = false {
+		more, err := d.next('}', first)
+		if err != nil || !more {
+			return err
+		}
+
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		var value *Item
+		if err := zealDecodeJSON5(d, &value); err != nil {
+			return err
+		}
+		(*v)[string(key)] = value
+	}
+}
+
+func zealAppendJSON10(dst []byte, v *map[Label]Level) ([]byte, error) {
+	if *v == nil {
+		return append(dst, "null"...), nil
+	}
+
+	keys := make([]string, 0, len(*v))
+	for key := range *v {
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+
+	dst = append(dst, '{')
+	for i, key := range keys {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+
+		dst = zealJSONAppendString(dst, key)
+		dst = append(dst, ':')
+		value := (*v)[Label(key)]
+		var err error
+		if dst, err = zealAppendJSONLevel(dst, &value); err != nil {
+			return dst, err
+		}
+	}
+
+	return append(dst, '}'), nil
+}
+
+func zealJSONAppendString(dst []byte, value string) []byte {
+	const hex = "0123456789abcdef"
+
+	// Invalid UTF-8 is rare, and its replacement differs between Go versions
+	if !utf8.ValidString(value) {
+		encoded, _ := json.Marshal(value)
+		return append(dst, encoded...)
+	}
+
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(value); {
+		if b := value[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+
+			dst = append(dst, value[start:i]...)
+			switch b {
+			case '\\', '"':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		c, size := utf8.DecodeRuneInString(value[i:])
+		if c == '\u2028' || c == '\u2029' {
+			dst = append(dst, value[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+
+		i += size
+	}
+	dst = append(dst, value[start:]...)
+
+	return append(dst, '"')
+}
+
+func zealJSONAppendFloat(dst []byte, value float64, bitSize int) ([]byte, error) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return dst, fmt.Errorf("unsupported JSON value: %v", strconv.FormatFloat(value, 'g', -1, bitSize))
+	}
+
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) || bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+
+	dst = strconv.AppendFloat(dst, value, format, -1, bitSize)
+	if format == 'e' {
+		// Clean up e-09 to e-9
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+
+	return dst, nil
+}
+
+// zealJSONDecoder decodes the first JSON value of a body strictly, like a json.Decoder with DisallowUnknownFields
+type zealJSONDecoder struct {
+	data []byte
+	pos  int
+}
+
+func zealJSONDecodeBody[T any](data []byte, body *T, decode func(*zealJSONDecoder, *T) error) error {
+	d := &zealJSONDecoder{data: data}
+	d.skipSpace()
+	if d.pos == len(d.data) {
+		return io.EOF
+	}
+
+	return decode(d, body)
+}
+
+func (d *zealJSONDecoder) skipSpace() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (d *zealJSONDecoder) invalid() error {
+	if d.pos >= len(d.data) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("unexpected JSON at offset %d: %q", d.pos, d.data[d.pos])
+}
+
+func (d *zealJSONDecoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+
+	return 0
+}
+
+func (d *zealJSONDecoder) consume(c byte) bool {
+	d.skipSpace()
+	if d.pos < len(d.data) && d.data[d.pos] == c {
+		d.pos++
+		return true
+	}
+
+	return false
+}
+
+func (d *zealJSONDecoder) literal(value string) bool {
+	d.skipSpace()
+	if len(d.data)-d.pos >= len(value) && string(d.data[d.pos:d.pos+len(value)]) == value {
+		d.pos += len(value)
+		return true
+	}
+
+	return false
+}
+
+func (d *zealJSONDecoder) isNull() bool {
+	return d.literal("null")
+}
+
+// next consumes the separator before the next element of an object or array, and reports whether there is one
+func (d *zealJSONDecoder) next(end byte, first bool) (bool, error) {
+	if first {
+		return !d.consume(end), nil
+	}
+
+	if d.consume(end) {
+		return false, nil
+	}
+
+	if d.consume(',') {
+		return true, nil
+	}
+
+	return false, d.invalid()
+}
+
+func (d *zealJSONDecoder) key() (string, error) {
+	key, err := d.readString()
+	if err != nil {
+		return "", err
+	}
+
+	if !d.consume(':') {
+		return "", d.invalid()
+	}
+
+	return key, nil
+}
+
+func (d *zealJSONDecoder) readBool() (bool, error) {
+	switch {
+	case d.literal("true"):
+		return true, nil
+	case d.literal("false"):
+		return false, nil
+	default:
+		return false, d.invalid()
+	}
+}
+
+func (d *zealJSONDecoder) readString() (string, error) {
+	raw, isPlain, err := d.readRawString()
+	if err != nil {
+		return "", err
+	}
+
+	if isPlain {
+		return string(raw[1 : len(raw)-1]), nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// readRawString returns a quoted string, and whether its contents are valid UTF-8 without escapes
+func (d *zealJSONDecoder) readRawString() ([]byte, bool, error) {
+	if !d.consume('"') {
+		return nil, false, d.invalid()
+	}
+
+	start, isPlain := d.pos-1, true
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		switch {
+		case c == '"':
+			d.pos++
+			raw := d.data[start:d.pos]
+			return raw, isPlain && utf8.Valid(raw), nil
+		case c < 0x20:
+			return nil, false, d.invalid()
+		case c == '\\':
+			isPlain = false
+			d.pos++
+			if d.pos >= len(d.data) {
+				return nil, false, d.invalid()
+			}
+
+			switch d.data[d.pos] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				d.pos++
+			case 'u':
+				d.pos++
+				for i := 0; i < 4; i++ {
+					if d.pos >= len(d.data) || !zealJSONIsHex(d.data[d.pos]) {
+						return nil, false, d.invalid()
+					}
+					d.pos++
+				}
+			default:
+				return nil, false, d.invalid()
+			}
+		default:
+			d.pos++
+		}
+	}
+
+	return nil, false, d.invalid()
+}
+
+func zealJSONIsHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func (d *zealJSONDecoder) readNumber() (string, error) {
+	d.skipSpace()
+	start := d.pos
+
+	if d.pos < len(d.data) && d.data[d.pos] == '-' {
+		d.pos++
+	}
+
+	switch {
+	case d.pos < len(d.data) && d.data[d.pos] == '0':
+		d.pos++
+	case d.pos < len(d.data) && '1' <= d.data[d.pos] && d.data[d.pos] <= '9':
+		d.skipDigits()
+	default:
+		return "", d.invalid()
+	}
+
+	if d.pos < len(d.data) && d.data[d.pos] == '.' {
+		d.pos++
+		if !d.skipDigits() {
+			return "", d.invalid()
+		}
+	}
+
+	if d.pos < len(d.data) && (d.data[d.pos] == 'e' || d.data[d.pos] == 'E') {
+		d.pos++
+		if d.pos < len(d.data) && (d.data[d.pos] == '+' || d.data[d.pos] == '-') {
+			d.pos++
+		}
+		if !d.skipDigits() {
+			return "", d.invalid()
+		}
+	}
+
+	return string(d.data[start:d.pos]), nil
+}
+
+func (d *zealJSONDecoder) skipDigits() bool {
+	start := d.pos
+	for d.pos < len(d.data) && '0' <= d.data[d.pos] && d.data[d.pos] <= '9' {
+		d.pos++
+	}
+
+	return d.pos > start
+}
+
+// skipValue checks the syntax of a value which isn't decoded, like the elements past the end of an array
+func (d *zealJSONDecoder) skipValue() error {
+	d.skipSpace()
+	if d.pos >= len(d.data) {
+		return d.invalid()
+	}
+
+	switch d.data[d.pos] {
+	case '{':
+		d.pos++
+		for first := true; ; first = false {
+			more, err := d.next('}', first)
+			if err != nil || !more {
+				return err
+			}
+
+			if _, _, err := d.readRawString(); err != nil {
+				return err
+			}
+			if !d.consume(':') {
+				return d.invalid()
+			}
+			if err := d.skipValue(); err != nil {
+				return err
+			}
+		}
+	case '[':
+		d.pos++
+		for first := true; ; first = false {
+			more, err := d.next(']', first)
+			if err != nil || !more {
+				return err
+			}
+
+			if err := d.skipValue(); err != nil {
+				return err
+			}
+		}
+	case '"':
+		_, _, err := d.readRawString()
+		return err
+	case 't', 'f':
+		_, err := d.readBool()
+		return err
+	case 'n':
+		if !d.isNull() {
+			return d.invalid()
+		}
+		return nil
+	default:
+		_, err := d.readNumber()
+		return err
+	}
+}
+
+func (d *zealJSONDecoder) unknownField(key string) error {
+	return fmt.Errorf("json: unknown field %q", key)
+}
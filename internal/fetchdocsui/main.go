@@ -0,0 +1,211 @@
+// Command fetchdocsui vendors the docs UI bundles served by zeal.ServeDocsUI into the docsui directory
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+type asset struct {
+	pkg     string
+	version string
+	path    string
+}
+
+// Pinned so that regenerating the assets never silently upgrades a UI
+var assets = map[string]asset{
+	"redoc.standalone.js":  {pkg: "redoc", version: "2.1.5", path: "bundles/redoc.standalone.js"},
+	"scalar.standalone.js": {pkg: "@scalar/api-reference", version: "1.25.0", path: "dist/browser/standalone.js"},
+	"rapidoc-min.js":       {pkg: "rapidoc", version: "9.3.4", path: "dist/rapidoc-min.js"},
+}
+
+const registryURL = "https://registry.npmjs.org"
+
+// The SHA-256 of each asset is pinned in sha256sum format, so a changed bundle is never vendored
+var checksumsPath = filepath.Join("docsui", "SHA256SUMS")
+
+func main() {
+	update := flag.Bool("update", false, "pin the checksums of the fetched assets, after changing their versions")
+	flag.Parse()
+
+	if err := run(*update); err != nil {
+		fmt.Fprintf(os.Stderr, "fetchdocsui: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(update bool) error {
+	checksums, err := readChecksums(checksumsPath)
+	if os.IsNotExist(err) && !update {
+		return fmt.Errorf("expected pinned checksums in %v, received none: run with -update", checksumsPath)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if checksums == nil {
+		checksums = make(map[string]string)
+	}
+
+	fetched := make(map[string][]byte)
+	for name, asset := range assets {
+		body, err := fetchAsset(asset)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(body)
+		checksum := hex.EncodeToString(sum[:])
+		if update {
+			checksums[name] = checksum
+		} else if checksums[name] == "" {
+			return fmt.Errorf("expected a pinned SHA-256 for %v in %v, received none: run with -update", name, checksumsPath)
+		} else if checksums[name] != checksum {
+			return fmt.Errorf("expected SHA-256 %v for %v, received: %v", checksums[name], name, checksum)
+		}
+
+		fetched[name] = body
+	}
+
+	// Assets are only written once all of them are verified
+	for name, body := range fetched {
+		if err := os.WriteFile(filepath.Join("docsui", name), body, 0o644); err != nil {
+			return err
+		}
+	}
+
+	if update {
+		return writeChecksums(checksumsPath, checksums)
+	}
+
+	return nil
+}
+
+// fetchAsset reads the asset from its package's tarball, which is checked against the integrity the registry
+// publishes for it, so even checksums pinned by -update don't depend on trusting a CDN
+func fetchAsset(asset asset) ([]byte, error) {
+	metadataBytes, err := fetch(registryURL + "/" + asset.pkg + "/" + asset.version)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata struct {
+		Dist struct {
+			Tarball   string `json:"tarball"`
+			Integrity string `json:"integrity"`
+		} `json:"dist"`
+	}
+	if err := json.Unmarshal(metadataBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse registry metadata of %v@%v: %w", asset.pkg, asset.version, err)
+	}
+
+	integrity, ok := strings.CutPrefix(metadata.Dist.Integrity, "sha512-")
+	if !ok {
+		return nil, fmt.Errorf("expected sha512 integrity for %v@%v, received: %q", asset.pkg, asset.version, metadata.Dist.Integrity)
+	}
+
+	tarball, err := fetch(metadata.Dist.Tarball)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha512.Sum512(tarball)
+	if received := base64.StdEncoding.EncodeToString(sum[:]); received != integrity {
+		return nil, fmt.Errorf("expected integrity sha512-%v for %v, received: sha512-%v", integrity, metadata.Dist.Tarball, received)
+	}
+
+	return readTarballFile(tarball, "package/"+asset.path)
+}
+
+func readTarballFile(tarball []byte, path string) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(tarball))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("expected %v in the package tarball, received none", path)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag == tar.TypeReg && header.Name == path {
+			return io.ReadAll(tarReader)
+		}
+	}
+}
+
+func fetch(url string) ([]byte, error) {
+	response, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected status 200 for %v, received: %v", url, response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+func readChecksums(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		checksum, name, ok := strings.Cut(line, "  ")
+		if !ok || len(checksum) != sha256.Size*2 {
+			return nil, fmt.Errorf("expected lines of a SHA-256 and a file name in %v, received: %q", path, line)
+		}
+
+		checksums[name] = strings.ToLower(checksum)
+	}
+
+	return checksums, scanner.Err()
+}
+
+func writeChecksums(path string, checksums map[string]string) error {
+	names := make([]string, 0, len(checksums))
+	for name := range checksums {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var content bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&content, "%v  %v\n", checksums[name], name)
+	}
+
+	return os.WriteFile(path, content.Bytes(), 0o644)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTarballFile(t *testing.T) {
+	var tarball bytes.Buffer
+	gzipWriter := gzip.NewWriter(&tarball)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range map[string]string{"package/README.md": "readme", "package/dist/ui.js": "bundle"} {
+		tarWriter.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))})
+		tarWriter.Write([]byte(content))
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+
+	file, err := readTarballFile(tarball.Bytes(), "package/dist/ui.js")
+	if err != nil || string(file) != "bundle" {
+		t.Fatalf("expected the bundle from the tarball, received: %q, %v", file, err)
+	}
+
+	if _, err := readTarballFile(tarball.Bytes(), "package/missing.js"); err == nil {
+		t.Fatal("expected an error for a file missing from the tarball, received none")
+	}
+}
+
+func TestChecksumsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "SHA256SUMS")
+	checksums := map[string]string{
+		"a.js": "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"b.js": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+
+	if err := writeChecksums(path, checksums); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := readChecksums(path)
+	if err != nil || !reflect.DeepEqual(read, checksums) {
+		t.Fatalf("expected %v, received: %v, %v", checksums, read, err)
+	}
+}
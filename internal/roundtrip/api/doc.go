@@ -0,0 +1,5 @@
+// Package api holds routes generated from a fixture spec, which the roundtrip test checks produce the spec again.
+// The models are generated into a package of their own, which imports nothing.
+package api
+
+//go:generate go run ../../../cmd/zeal gen routes -spec openapi.yaml -package api -o routes_gen.go -stubs handlers.go -models ../models/models_gen.go -models-import github.com/DandyCodes/zeal/internal/roundtrip/models
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/DandyCodes/zeal"
+)
+
+type Server struct{}
+
+var _ Handlers = (*Server)(nil)
+
+func (s *Server) ListMenus(route *ListMenusRoute, w http.ResponseWriter, r *http.Request) error {
+	return zeal.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *Server) CreateMenu(route *CreateMenuRoute, w http.ResponseWriter, r *http.Request) error {
+	return zeal.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *Server) GetMenu(route *GetMenuRoute, w http.ResponseWriter, r *http.Request) error {
+	return zeal.Error(w, "Not implemented", http.StatusNotImplemented)
+}
+
+func (s *Server) DeleteMenu(route *DeleteMenuRoute, w http.ResponseWriter, r *http.Request) error {
+	return zeal.Error(w, "Not implemented", http.StatusNotImplemented)
+}
@@ -0,0 +1,76 @@
+// Code generated by zeal. DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/DandyCodes/zeal"
+	"github.com/DandyCodes/zeal/internal/roundtrip/models"
+)
+
+type ListMenusRoute struct {
+	zeal.Route
+	zeal.HasResponse[[]models.Menu]
+}
+
+type CreateMenuRoute struct {
+	zeal.Route
+	zeal.HasBody[models.Menu]
+	zeal.HasResponse[models.Menu]
+}
+
+type GetMenuParams struct {
+	ID      int
+	Verbose bool
+}
+
+type GetMenuRoute struct {
+	zeal.Route
+	zeal.HasParams[GetMenuParams]
+	zeal.HasResponse[models.Menu]
+}
+
+type DeleteMenuParams struct {
+	ID int
+}
+
+type DeleteMenuRoute struct {
+	zeal.Route
+	zeal.HasParams[DeleteMenuParams]
+	zeal.HasResponse[string]
+}
+
+type Handlers interface {
+	ListMenus(route *ListMenusRoute, w http.ResponseWriter, r *http.Request) error
+	CreateMenu(route *CreateMenuRoute, w http.ResponseWriter, r *http.Request) error
+	GetMenu(route *GetMenuRoute, w http.ResponseWriter, r *http.Request) error
+	DeleteMenu(route *DeleteMenuRoute, w http.ResponseWriter, r *http.Request) error
+}
+
+func RegisterRoutes(mux *zeal.ZealMux, handlers Handlers) {
+	{
+		route := zeal.NewRoute[ListMenusRoute](mux, zeal.RouteOptions{OperationID: "listMenus", Summary: "List menus", Tags: []string{"menus"}})
+		route.HandleFuncErr("GET /menus", func(w http.ResponseWriter, r *http.Request) error {
+			return handlers.ListMenus(route, w, r)
+		})
+	}
+	{
+		route := zeal.NewRoute[CreateMenuRoute](mux, zeal.RouteOptions{OperationID: "createMenu"})
+		route.HandleFuncErr("PUT /menus", func(w http.ResponseWriter, r *http.Request) error {
+			return handlers.CreateMenu(route, w, r)
+		})
+	}
+	{
+		route := zeal.NewRoute[GetMenuRoute](mux, zeal.RouteOptions{OperationID: "getMenu"})
+		route.HandleFuncErr("GET /menus/{ID}", func(w http.ResponseWriter, r *http.Request) error {
+			return handlers.GetMenu(route, w, r)
+		})
+	}
+	{
+		route := zeal.NewRoute[DeleteMenuRoute](mux, zeal.RouteOptions{OperationID: "deleteMenu", Deprecated: true})
+		route.HandleFuncErr("DELETE /menus/{ID}", func(w http.ResponseWriter, r *http.Request) error {
+			return handlers.DeleteMenu(route, w, r)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by zeal. DO NOT EDIT.
+
+package models
+
+type Item struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+	Size  Size    `json:"size,omitempty"`
+}
+
+type Menu struct {
+	Id    int     `json:"id"`
+	Items []Item  `json:"items"`
+	Note  *string `json:"note"`
+}
+
+type Size string
+
+const (
+	SizeSmall Size = "small"
+	SizeLarge Size = "large"
+)
+
+func (Size) Enum() []Size {
+	return []Size{SizeSmall, SizeLarge}
+}
@@ -0,0 +1,28 @@
+package roundtrip
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+	"github.com/DandyCodes/zeal/internal/roundtrip/api"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestGeneratedRoutesMatchSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromFile("api/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := zeal.NewZealMux(http.NewServeMux(), spec.Info.Title)
+	api.RegisterRoutes(mux, &api.Server{})
+
+	if _, err := zeal.NewOpenAPISpec(zeal.SpecOptions{ZealMux: mux, Version: spec.Info.Version}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := zeal.VerifySpec(mux, spec); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,498 @@
+package zeal
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const jwksRefreshInterval = time.Minute
+
+type JWTKey struct {
+	ID        string
+	Algorithm string
+	Key       any
+}
+
+type JWTOptions struct {
+	Keys       []JWTKey
+	JWKSFile   string
+	JWKSURL    string
+	HTTPClient *http.Client
+	Issuer     string
+	Audience   string
+	Leeway     time.Duration
+	// AllowMissingExpiry accepts tokens without an exp claim, which are otherwise rejected
+	AllowMissingExpiry bool
+}
+
+type JWTAuthenticator struct {
+	options     JWTOptions
+	keys        []JWTKey
+	keysMutex   sync.RWMutex
+	fetchMutex  sync.Mutex
+	lastFetched time.Time
+}
+
+func NewJWTAuthenticator(options JWTOptions) (*JWTAuthenticator, error) {
+	authenticator := &JWTAuthenticator{options: options, keys: slices.Clone(options.Keys)}
+
+	if options.JWKSFile != "" {
+		jwks, err := os.ReadFile(options.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, err := parseJWKS(jwks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS file %v: %w", options.JWKSFile, err)
+		}
+
+		authenticator.keys = append(authenticator.keys, keys...)
+	}
+
+	if options.JWKSURL != "" {
+		if err := authenticator.fetchJWKS(); err != nil {
+			return nil, err
+		}
+		authenticator.lastFetched = time.Now()
+	}
+
+	if len(authenticator.keys) == 0 && options.JWKSURL == "" {
+		return nil, fmt.Errorf("expected JWT keys, received none")
+	}
+
+	return authenticator, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(r *http.Request, credential string, scopes []string) (context.Context, error) {
+	claims, err := a.verify(credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	grantedScopes := getJWTScopes(claims.values)
+	for _, scope := range scopes {
+		if !slices.Contains(grantedScopes, scope) {
+			return nil, fmt.Errorf("%w: missing scope %v", ErrForbidden, scope)
+		}
+	}
+
+	return context.WithValue(r.Context(), claimsContextKey{}, claims.payload), nil
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	payload []byte
+	values  map[string]any
+}
+
+func (a *JWTAuthenticator) verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, fmt.Errorf("expected JWT with 3 parts, received: %v", len(parts))
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return jwtClaims{}, fmt.Errorf("failed to decode JWT header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("failed to decode JWT signature: %w", err)
+	}
+
+	keys, err := a.getKeys(header)
+	if err != nil {
+		return jwtClaims{}, err
+	}
+
+	signingInput := []byte(parts[0] + "." + parts[1])
+	if !slices.ContainsFunc(keys, func(key JWTKey) bool {
+		return verifyJWTSignature(header.Algorithm, key.Key, signingInput, signature)
+	}) {
+		return jwtClaims{}, fmt.Errorf("invalid JWT signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return jwtClaims{}, fmt.Errorf("failed to decode JWT claims: %w", err)
+	}
+
+	claims := jwtClaims{payload: payload}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims.values); err != nil {
+		return jwtClaims{}, fmt.Errorf("failed to decode JWT claims: %w", err)
+	}
+
+	if err := a.validateClaims(claims.values); err != nil {
+		return jwtClaims{}, err
+	}
+
+	return claims, nil
+}
+
+func (a *JWTAuthenticator) getKeys(header jwtHeader) ([]JWTKey, error) {
+	keys := a.findKeys(header)
+	if len(keys) > 0 {
+		return keys, nil
+	}
+
+	if a.options.JWKSURL != "" {
+		if err := a.refetchJWKS(); err != nil {
+			return nil, err
+		}
+
+		keys = a.findKeys(header)
+		if len(keys) > 0 {
+			return keys, nil
+		}
+	}
+
+	return nil, fmt.Errorf("expected known JWT key, received: kid %q alg %q", header.KeyID, header.Algorithm)
+}
+
+func (a *JWTAuthenticator) findKeys(header jwtHeader) []JWTKey {
+	a.keysMutex.RLock()
+	defer a.keysMutex.RUnlock()
+
+	var keys []JWTKey
+	for _, key := range a.keys {
+		if header.KeyID != "" && key.ID != "" && key.ID != header.KeyID {
+			continue
+		}
+
+		if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+			continue
+		}
+
+		if !isJWTKeyAlgorithm(key.Key, header.Algorithm) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// refetchJWKS fetches the JWKS at most once per interval. Concurrent requests wait for the fetch in flight
+// and use its keys, and failed fetches also wait out the interval, so unknown keys can't flood the endpoint.
+func (a *JWTAuthenticator) refetchJWKS() error {
+	a.fetchMutex.Lock()
+	defer a.fetchMutex.Unlock()
+
+	if time.Since(a.lastFetched) <= jwksRefreshInterval {
+		return nil
+	}
+	a.lastFetched = time.Now()
+
+	return a.fetchJWKS()
+}
+
+func (a *JWTAuthenticator) fetchJWKS() error {
+	client := a.options.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	response, err := client.Get(a.options.JWKSURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS, received status: %v", response.StatusCode)
+	}
+
+	jwks, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys, err := parseJWKS(jwks)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWKS from %v: %w", a.options.JWKSURL, err)
+	}
+
+	a.keysMutex.Lock()
+	defer a.keysMutex.Unlock()
+	a.keys = append(slices.Clone(a.options.Keys), keys...)
+
+	return nil
+}
+
+func (a *JWTAuthenticator) validateClaims(claims map[string]any) error {
+	now := time.Now()
+
+	expiresAt, ok := getJWTTime(claims, "exp")
+	if !ok && !a.options.AllowMissingExpiry {
+		return fmt.Errorf("expected exp claim, received none")
+	}
+	if ok && now.After(expiresAt.Add(a.options.Leeway)) {
+		return fmt.Errorf("token expired at %v", expiresAt)
+	}
+
+	if notBefore, ok := getJWTTime(claims, "nbf"); ok && now.Add(a.options.Leeway).Before(notBefore) {
+		return fmt.Errorf("token not valid before %v", notBefore)
+	}
+
+	if a.options.Issuer != "" && claims["iss"] != a.options.Issuer {
+		return fmt.Errorf("expected issuer %v, received: %v", a.options.Issuer, claims["iss"])
+	}
+
+	if a.options.Audience != "" && !slices.Contains(getJWTStrings(claims["aud"]), a.options.Audience) {
+		return fmt.Errorf("expected audience %v, received: %v", a.options.Audience, claims["aud"])
+	}
+
+	return nil
+}
+
+func getJWTTime(claims map[string]any, name string) (time.Time, bool) {
+	number, ok := claims[name].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(seconds), 0), true
+}
+
+func getJWTScopes(claims map[string]any) []string {
+	scopes := getJWTStrings(claims["scp"])
+	if scope, ok := claims["scope"].(string); ok {
+		scopes = append(scopes, strings.Fields(scope)...)
+	}
+
+	return scopes
+}
+
+func getJWTStrings(claim any) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		var values []string
+		for _, item := range value {
+			if itemString, ok := item.(string); ok {
+				values = append(values, itemString)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func decodeJWTPart(part string, into any) error {
+	partBytes, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(partBytes, into)
+}
+
+func isJWTKeyAlgorithm(key any, algorithm string) bool {
+	switch key := key.(type) {
+	case []byte:
+		return algorithm == "HS256"
+	case *rsa.PublicKey:
+		return algorithm == "RS256"
+	case *ecdsa.PublicKey:
+		return algorithm == "ES256" && key.Curve == elliptic.P256()
+	case ed25519.PublicKey:
+		return algorithm == "EdDSA"
+	default:
+		return false
+	}
+}
+
+func verifyJWTSignature(algorithm string, key any, signingInput, signature []byte) bool {
+	if !isJWTKeyAlgorithm(key, algorithm) {
+		return false
+	}
+
+	hash := sha256.Sum256(signingInput)
+
+	switch key := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, key)
+		mac.Write(signingInput)
+		return hmac.Equal(mac.Sum(nil), signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case *ecdsa.PublicKey:
+		if len(signature) != 64 {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(key, hash[:], r, s)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, signingInput, signature)
+	default:
+		return false
+	}
+}
+
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	K         string `json:"k"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+func parseJWKS(jwks []byte) ([]JWTKey, error) {
+	var keySet struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &keySet); err != nil {
+		return nil, err
+	}
+
+	var keys []JWTKey
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.parse()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWK %q: %w", jwk.KeyID, err)
+		}
+
+		keys = append(keys, JWTKey{ID: jwk.KeyID, Algorithm: jwk.Algorithm, Key: key})
+	}
+
+	return keys, nil
+}
+
+func (jwk jwk) parse() (any, error) {
+	switch jwk.KeyType {
+	case "oct":
+		return base64.RawURLEncoding.DecodeString(jwk.K)
+	case "RSA":
+		n, err := decodeJWKInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if jwk.Curve != "P-256" {
+			return nil, fmt.Errorf("expected P-256 curve, received: %v", jwk.Curve)
+		}
+		x, err := decodeJWKInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return nil, fmt.Errorf("expected Ed25519 curve, received: %v", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("expected Ed25519 public key size, received: %v", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("expected supported key type, received: %v", jwk.KeyType)
+	}
+}
+
+func decodeJWKInt(value string) (*big.Int, error) {
+	valueBytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(valueBytes), nil
+}
+
+type claimsContextKey struct{}
+
+type HasClaims[T_Claims any] struct {
+	request *http.Request
+	claims  T_Claims
+}
+
+func (c *HasClaims[T_Claims]) Claims() T_Claims {
+	return c.claims
+}
+
+func (c *HasClaims[T_Claims]) Validate(request *http.Request) (T_Claims, error) {
+	err := c.bindRequest(request)
+	return c.claims, err
+}
+
+func (c *HasClaims[T_Claims]) compileBinding() {}
+
+func (c *HasClaims[T_Claims]) bindRequest(request *http.Request) error {
+	c.request = request
+
+	claims, err := getClaims[T_Claims](request)
+	c.claims = claims
+
+	return err
+}
+
+func getClaims[T_Claims any](request *http.Request) (T_Claims, error) {
+	var claims T_Claims
+
+	payload, ok := request.Context().Value(claimsContextKey{}).([]byte)
+	if !ok {
+		return claims, fmt.Errorf("%w: missing JWT claims", ErrUnauthenticated)
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, fmt.Errorf("%w: failed to decode JWT claims: %w", ErrUnauthenticated, err)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,322 @@
+package zeal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type testJWTKeys struct {
+	hmac    []byte
+	rsa     *rsa.PrivateKey
+	ecdsa   *ecdsa.PrivateKey
+	ed25519 ed25519.PrivateKey
+}
+
+func newTestJWTKeys(t *testing.T) testJWTKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testJWTKeys{hmac: []byte("0123456789abcdef0123456789abcdef"), rsa: rsaKey, ecdsa: ecdsaKey, ed25519: ed25519Key}
+}
+
+func signTestJWT(t *testing.T, header map[string]any, claims map[string]any, sign func(signingInput []byte) []byte) string {
+	t.Helper()
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign([]byte(signingInput)))
+}
+
+func (keys testJWTKeys) sign(t *testing.T, algorithm string, claims map[string]any) string {
+	t.Helper()
+
+	return signTestJWT(t, map[string]any{"alg": algorithm, "typ": "JWT"}, claims, func(signingInput []byte) []byte {
+		hash := sha256.Sum256(signingInput)
+
+		switch algorithm {
+		case "HS256":
+			return signHS256(keys.hmac, signingInput)
+		case "RS256":
+			signature, err := rsa.SignPKCS1v15(rand.Reader, keys.rsa, crypto.SHA256, hash[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return signature
+		case "ES256":
+			r, s, err := ecdsa.Sign(rand.Reader, keys.ecdsa, hash[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			signature := make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+			return signature
+		case "EdDSA":
+			return ed25519.Sign(keys.ed25519, signingInput)
+		default:
+			t.Fatalf("expected supported algorithm, received: %v", algorithm)
+			return nil
+		}
+	})
+}
+
+func signHS256(key, signingInput []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(signingInput)
+	return mac.Sum(nil)
+}
+
+// tamperTestJWT swaps the claims of a token, keeping its signature
+func tamperTestJWT(token string, claims map[string]any) string {
+	parts := strings.Split(token, ".")
+	claimsJSON, _ := json.Marshal(claims)
+	parts[1] = base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	return strings.Join(parts, ".")
+}
+
+func (keys testJWTKeys) jwtKeys() []JWTKey {
+	return []JWTKey{
+		{Key: keys.hmac},
+		{Key: &keys.rsa.PublicKey},
+		{Key: &keys.ecdsa.PublicKey},
+		{Key: keys.ed25519.Public()},
+	}
+}
+
+func validTestClaims() map[string]any {
+	return map[string]any{
+		"sub":   "user",
+		"iss":   "issuer",
+		"aud":   []string{"api"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "read write",
+	}
+}
+
+func withTestClaims(changes map[string]any) map[string]any {
+	claims := validTestClaims()
+	for name, value := range changes {
+		if value == nil {
+			delete(claims, name)
+			continue
+		}
+		claims[name] = value
+	}
+
+	return claims
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	keys := newTestJWTKeys(t)
+	authenticator, err := NewJWTAuthenticator(JWTOptions{Keys: keys.jwtKeys(), Issuer: "issuer", Audience: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsaPublicKey, err := x509.MarshalPKIXPublicKey(&keys.rsa.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hs256WithRSAKey := signTestJWT(t, map[string]any{"alg": "HS256"}, validTestClaims(), func(signingInput []byte) []byte {
+		return signHS256(rsaPublicKey, signingInput)
+	})
+
+	tests := []struct {
+		name   string
+		token  string
+		scopes []string
+		err    error
+	}{
+		{"HS256", keys.sign(t, "HS256", validTestClaims()), nil, nil},
+		{"RS256", keys.sign(t, "RS256", validTestClaims()), nil, nil},
+		{"ES256", keys.sign(t, "ES256", validTestClaims()), nil, nil},
+		{"EdDSA", keys.sign(t, "EdDSA", validTestClaims()), nil, nil},
+		{"granted scopes", keys.sign(t, "RS256", validTestClaims()), []string{"read", "write"}, nil},
+		{"HS256 signed with the RSA public key", hs256WithRSAKey, nil, ErrUnauthenticated},
+		{"unsupported algorithm", signTestJWT(t, map[string]any{"alg": "none"}, validTestClaims(), func([]byte) []byte { return nil }), nil, ErrUnauthenticated},
+		{"tampered claims", tamperTestJWT(keys.sign(t, "ES256", validTestClaims()), withTestClaims(map[string]any{"scope": "admin"})), nil, ErrUnauthenticated},
+		{"expired", keys.sign(t, "RS256", withTestClaims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()})), nil, ErrUnauthenticated},
+		{"missing exp", keys.sign(t, "RS256", withTestClaims(map[string]any{"exp": nil})), nil, ErrUnauthenticated},
+		{"not yet valid", keys.sign(t, "RS256", withTestClaims(map[string]any{"nbf": time.Now().Add(time.Hour).Unix()})), nil, ErrUnauthenticated},
+		{"wrong issuer", keys.sign(t, "RS256", withTestClaims(map[string]any{"iss": "other"})), nil, ErrUnauthenticated},
+		{"wrong audience", keys.sign(t, "RS256", withTestClaims(map[string]any{"aud": "other"})), nil, ErrUnauthenticated},
+		{"insufficient scopes", keys.sign(t, "RS256", validTestClaims()), []string{"admin"}, ErrForbidden},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			ctx, err := authenticator.Authenticate(r, test.token, test.scopes)
+
+			if test.err == nil {
+				if err != nil {
+					t.Fatalf("expected no error, received: %v", err)
+				}
+				if claims, err := getClaims[map[string]any](r.WithContext(ctx)); err != nil || claims["sub"] != "user" {
+					t.Fatalf("expected the token's claims in the context, received: %v, %v", claims, err)
+				}
+				return
+			}
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, received: %v", test.err, err)
+			}
+			if test.err == ErrUnauthenticated && errors.Is(err, ErrForbidden) {
+				t.Fatalf("expected an unauthenticated error, received: %v", err)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticatorBindsKeysToAlgorithms(t *testing.T) {
+	keys := newTestJWTKeys(t)
+	authenticator, err := NewJWTAuthenticator(JWTOptions{Keys: []JWTKey{{Algorithm: "RS256", Key: keys.hmac}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if _, err := authenticator.Authenticate(r, keys.sign(t, "HS256", validTestClaims()), nil); !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("expected a key declared for RS256 to reject an HS256 token, received: %v", err)
+	}
+}
+
+func TestJWTAuthenticatorLeeway(t *testing.T) {
+	keys := newTestJWTKeys(t)
+	authenticator, err := NewJWTAuthenticator(JWTOptions{Keys: keys.jwtKeys(), Leeway: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := keys.sign(t, "EdDSA", withTestClaims(map[string]any{"exp": time.Now().Add(-30 * time.Second).Unix()}))
+	if _, err := authenticator.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil), token, nil); err != nil {
+		t.Fatalf("expected a token expired within the leeway to be accepted, received: %v", err)
+	}
+}
+
+func TestJWTAuthenticatorJWKS(t *testing.T) {
+	keys := newTestJWTKeys(t)
+	ed25519Public := keys.ed25519.Public().(ed25519.PublicKey)
+
+	jwks := []map[string]string{
+		{"kty": "RSA", "kid": "rsa", "alg": "RS256", "n": encodeJWKInt(keys.rsa.N), "e": encodeJWKInt(big.NewInt(int64(keys.rsa.E)))},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeJWKInt(keys.ecdsa.X), "y": encodeJWKInt(keys.ecdsa.Y)},
+		{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": base64.RawURLEncoding.EncodeToString(ed25519Public)},
+		{"kty": "oct", "kid": "enc", "use": "enc", "k": base64.RawURLEncoding.EncodeToString(keys.hmac)},
+	}
+
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		json.NewEncoder(w).Encode(map[string]any{"keys": jwks})
+	}))
+	defer server.Close()
+
+	authenticator, err := NewJWTAuthenticator(JWTOptions{JWKSURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, algorithm := range []string{"RS256", "ES256", "EdDSA"} {
+		if _, err := authenticator.Authenticate(r, keys.sign(t, algorithm, validTestClaims()), nil); err != nil {
+			t.Errorf("expected a %v token to verify against the JWKS, received: %v", algorithm, err)
+		}
+	}
+
+	// The oct key is for encryption, so it is skipped, and tokens it signs send the authenticator to the JWKS
+	for i := 0; i < 3; i++ {
+		if _, err := authenticator.Authenticate(r, keys.sign(t, "HS256", validTestClaims()), nil); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("expected a token with an unknown key to be rejected, received: %v", err)
+		}
+	}
+
+	if fetches.Load() != 1 {
+		t.Fatalf("expected the JWKS to be fetched once within the refresh interval, received: %v fetches", fetches.Load())
+	}
+
+	authenticator.lastFetched = time.Now().Add(-2 * jwksRefreshInterval)
+	authenticator.Authenticate(r, keys.sign(t, "HS256", validTestClaims()), nil)
+	if fetches.Load() != 2 {
+		t.Fatalf("expected the JWKS to be fetched again after the refresh interval, received: %v fetches", fetches.Load())
+	}
+}
+
+func encodeJWKInt(value *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(value.Bytes())
+}
+
+func TestJWTInsufficientScopesAreForbidden(t *testing.T) {
+	keys := newTestJWTKeys(t)
+	authenticator, err := NewJWTAuthenticator(JWTOptions{Keys: keys.jwtKeys()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := NewZealMux(http.NewServeMux())
+	mux.AddSecurityScheme("bearer", NewBearerScheme("JWT", authenticator))
+	mux.RequireSecurity(openapi3.SecurityRequirement{"bearer": {"admin"}})
+	var route = NewRoute[Route](mux)
+	route.HandleFunc("GET /admin", func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		claims map[string]any
+		status int
+	}{
+		{validTestClaims(), http.StatusForbidden},
+		{withTestClaims(map[string]any{"scope": "admin"}), http.StatusOK},
+		{withTestClaims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}), http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/admin", nil)
+		r.Header.Set("Authorization", "Bearer "+keys.sign(t, "ES256", test.claims))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		if w.Code != test.status {
+			t.Errorf("expected status %v for claims %v, received: %v", test.status, test.claims, w.Code)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package zeal
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// liveSpec is shared by a mux and its groups, and caches the spec until the registry changes
+type liveSpec struct {
+	mu           sync.RWMutex
+	options      SpecOptions
+	customizers  []func(*openapi3.T)
+	version      uint64
+	built        *openapi3.T
+	builtVersion uint64
+	mounts       []*mount
+}
+
+// mount records what a mux passed to Handle has merged into the registry of the mux handling it,
+// so changes made to it later can be merged without repeating earlier ones
+type mount struct {
+	parent     *ZealMux
+	child      *ZealMux
+	prefix     string
+	claimed    map[string]bool
+	customized map[string]int
+}
+
+// updateRegistry makes a change which affects the spec, and merges it into the muxes the mux is mounted in
+func (m *ZealMux) updateRegistry(update func()) {
+	m.spec.mu.Lock()
+	defer m.spec.mu.Unlock()
+
+	update()
+	m.spec.version++
+
+	for _, mount := range m.spec.mounts {
+		mount.parent.updateRegistry(mount.merge)
+	}
+}
+
+// merge runs with the registries of both muxes locked
+func (mount *mount) merge() {
+	parent, child := mount.parent, mount.child
+
+	mergeMap(parent.securitySchemes, child.securitySchemes)
+	for _, err := range *child.errs {
+		parent.addError(err)
+	}
+	for _, subPattern := range child.routes {
+		if mount.claimed[subPattern] {
+			continue
+		}
+		mount.claimed[subPattern] = true
+
+		method, path, _ := strings.Cut(subPattern, " ")
+		path = mount.prefix + path
+		if err := parent.claimRoute(method, path, method+" "+path); err != nil {
+			parent.addError(err)
+		}
+	}
+	for _, methodToRoute := range child.Api.Routes {
+		for _, route := range methodToRoute {
+			mergeRoute(mount.prefix, parent.Api, route)
+		}
+	}
+	for key, info := range child.routeInfos {
+		method, path, _ := strings.Cut(key, " ")
+		info.Path = mount.prefix + info.Path
+		info.Pattern = method + " " + info.Path
+		parent.routeInfos[method+" "+mount.prefix+path] = info
+	}
+	for key, customOperations := range child.customOperations {
+		method, path, _ := strings.Cut(key, " ")
+		for _, customize := range customOperations[mount.customized[key]:] {
+			parent.customizeOperation(method, mount.prefix+path, customize)
+		}
+		mount.customized[key] = len(customOperations)
+	}
+}
+
+// readRegistry reads the registries of the mux and the muxes it is nested in, as handlers do while routes may still change
+func (m *ZealMux) readRegistry(read func()) {
+	m.spec.mu.RLock()
+	defer m.spec.mu.RUnlock()
+
+	unlock := m.lockAncestors()
+	defer unlock()
+
+	read()
+}
+
+// lockAncestors read locks the registries of the muxes the mux is nested in, which it doesn't share a lock with.
+// The mux's own registry must already be locked.
+func (m *ZealMux) lockAncestors() func() {
+	var locked []*liveSpec
+	for mux := m.parent; mux != nil; mux = mux.parent {
+		if mux.spec != m.spec && !slices.Contains(locked, mux.spec) {
+			mux.spec.mu.RLock()
+			locked = append(locked, mux.spec)
+		}
+	}
+
+	return func() {
+		for _, spec := range locked {
+			spec.mu.RUnlock()
+		}
+	}
+}
+
+func (m *ZealMux) Spec() (*openapi3.T, error) {
+	m.spec.mu.Lock()
+	defer m.spec.mu.Unlock()
+
+	if m.spec.built != nil && m.spec.builtVersion == m.spec.version {
+		return m.spec.built, nil
+	}
+
+	unlock := m.lockAncestors()
+	defer unlock()
+
+	options := m.spec.options
+	options.ZealMux = m
+	spec, err := buildOpenAPISpec(options)
+	if err != nil {
+		return nil, err
+	}
+
+	m.spec.built, m.spec.builtVersion = spec, m.spec.version
+
+	return spec, nil
+}
+
+func (m *ZealMux) customizeSpec(customize func(*openapi3.T)) {
+	m.updateRegistry(func() {
+		m.spec.customizers = append(m.spec.customizers, customize)
+	})
+}
+
+// newSpecHandler serves an encoding of the spec, or of the mux's live spec if the spec is nil
+func newSpecHandler(mux *ZealMux, openAPISpec *openapi3.T, encode func(*openapi3.T) ([]byte, error), contentType string) (http.Handler, error) {
+	if openAPISpec == nil {
+		return &liveSpecHandler{mux: mux, encode: encode, contentType: contentType}, nil
+	}
+
+	body, err := encode(openAPISpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStaticHandler(body, contentType)
+}
+
+type liveSpecHandler struct {
+	mux         *ZealMux
+	encode      func(*openapi3.T) ([]byte, error)
+	contentType string
+	mu          sync.Mutex
+	spec        *openapi3.T
+	handler     *staticHandler
+}
+
+func (h *liveSpecHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, err := h.getHandler()
+	if err != nil {
+		http.Error(w, "Failed to create OpenAPI spec", http.StatusInternalServerError)
+		return
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+func (h *liveSpecHandler) getHandler() (*staticHandler, error) {
+	spec, err := h.mux.Spec()
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// A rebuilt spec is a new value, so the encoding is only redone when the spec changes
+	if spec != h.spec {
+		body, err := h.encode(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		handler, err := newStaticHandler(body, h.contentType)
+		if err != nil {
+			return nil, err
+		}
+
+		h.spec, h.handler = spec, handler
+	}
+
+	return h.handler, nil
+}
@@ -0,0 +1,130 @@
+package zeal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestRegistryChangesWhileServing(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	mux.AddAPIKeyScheme("key", "header", "X-Key", NewMemoryKeyStore())
+	group := mux.Group("/group")
+	nested := NewZealMux(http.NewServeMux())
+	nested.EnableContractTest(ContractTestOptions{Report: func(...any) {}})
+
+	var route = NewRoute[Route](group)
+	route.HandleFunc("GET /items", func(w http.ResponseWriter, r *http.Request) {})
+	var nestedRoute = NewRoute[Route](nested)
+	nestedRoute.HandleFunc("GET /items", func(w http.ResponseWriter, r *http.Request) {})
+	if err := ServeSpec(mux, nil, "GET /spec/"); err != nil {
+		t.Fatal(err)
+	}
+	mux.Handle("/", nested)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				for _, target := range []string{"/group/items", "/items", "/spec/openapi.json"} {
+					mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				name := fmt.Sprintf("scheme%d_%d", i, j)
+				group.AddSecurityScheme(name, NewBearerScheme("", AuthenticatorFunc(func(r *http.Request, credential string, scopes []string) (context.Context, error) {
+					return nil, nil
+				})))
+				var groupRoute = NewRoute[Route](group)
+				groupRoute.HandleFunc(fmt.Sprintf("GET /added%d_%d", i, j), func(w http.ResponseWriter, r *http.Request) {})
+				var nestedRoute = NewRoute[Route](nested)
+				nestedRoute.HandleFunc(fmt.Sprintf("GET /nested%d_%d", i, j), func(w http.ResponseWriter, r *http.Request) {})
+				mux.RequireSecurity(openapi3.SecurityRequirement{})
+				group.Use(StdMiddleware(func(next http.Handler) http.Handler { return next }))
+				nested.EnableContractTest()
+			}
+		}()
+	}
+	wg.Wait()
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/spec/openapi.json", nil))
+	var spec openapi3.T
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("expected the served spec, received: %v %v", w.Code, err)
+	}
+
+	expectedPaths := []string{"/group/items", "/items"}
+	var expectedSchemes []string
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 20; j++ {
+			expectedPaths = append(expectedPaths, fmt.Sprintf("/group/added%d_%d", i, j), fmt.Sprintf("/nested%d_%d", i, j))
+			expectedSchemes = append(expectedSchemes, fmt.Sprintf("scheme%d_%d", i, j))
+		}
+	}
+
+	for _, path := range expectedPaths {
+		if spec.Paths.Find(path) == nil {
+			t.Errorf("expected path %v in the served spec, received none", path)
+		}
+	}
+	for _, name := range expectedSchemes {
+		if _, ok := spec.Components.SecuritySchemes[name]; !ok {
+			t.Errorf("expected security scheme %v in the served spec, received none", name)
+		}
+	}
+}
+
+func TestNestedMuxChangesAfterHandle(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	nested := NewZealMux(http.NewServeMux())
+	inner := NewZealMux(http.NewServeMux())
+	mux.Handle("/v1/", nested)
+	nested.Handle("/inner/", inner)
+
+	var route = NewRoute[Route](nested, RouteOptions{Summary: "List items"})
+	route.HandleFunc("GET /items", func(w http.ResponseWriter, r *http.Request) {})
+	var innerRoute = NewRoute[Route](inner.Group("/deep"))
+	innerRoute.HandleFunc("GET /things", func(w http.ResponseWriter, r *http.Request) {})
+	nested.AddSecurityScheme("bearer", newTestBearerScheme())
+
+	spec, err := mux.Spec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := spec.Paths.Find("/v1/items")
+	if items == nil || items.Get == nil || items.Get.Summary != "List items" {
+		t.Fatalf("expected the customized operation GET /v1/items, received: %v", items)
+	}
+	if spec.Paths.Find("/v1/inner/deep/things") == nil {
+		t.Fatalf("expected path /v1/inner/deep/things, received: %v", spec.Paths.InMatchingOrder())
+	}
+	if _, ok := spec.Components.SecuritySchemes["bearer"]; !ok {
+		t.Fatalf("expected security scheme bearer, received: %v", spec.Components.SecuritySchemes)
+	}
+
+	// A route is documented once however many changes follow it
+	nested.AddSecurityScheme("other", newTestBearerScheme())
+	if err := mux.Validate(); err != nil {
+		t.Fatalf("expected no route errors, received: %v", err)
+	}
+	spec, err = mux.Spec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary := spec.Paths.Find("/v1/items").Get.Summary; summary != "List items" {
+		t.Fatalf("expected summary List items, received: %v", summary)
+	}
+}
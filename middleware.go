@@ -0,0 +1,138 @@
+package zeal
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type RouteInfo struct {
+	Pattern      string
+	Method       string
+	Path         string
+	OperationID  string
+	Tags         []string
+	RouteType    reflect.Type
+	ClaimsType   reflect.Type
+	ParamsType   reflect.Type
+	BodyType     reflect.Type
+	ResponseType reflect.Type
+	middlewares  []Middleware
+}
+
+type Middleware interface {
+	Middleware(info RouteInfo, next http.Handler) http.Handler
+}
+
+type MiddlewareFunc func(info RouteInfo, next http.Handler) http.Handler
+
+func (f MiddlewareFunc) Middleware(info RouteInfo, next http.Handler) http.Handler {
+	return f(info, next)
+}
+
+type OperationCustomizer interface {
+	CustomizeOperation(info RouteInfo, operation *openapi3.Operation) error
+}
+
+func StdMiddleware(middleware func(http.Handler) http.Handler) Middleware {
+	return MiddlewareFunc(func(info RouteInfo, next http.Handler) http.Handler {
+		return middleware(next)
+	})
+}
+
+func (m *ZealMux) Use(middlewares ...Middleware) {
+	m.updateRegistry(func() {
+		m.middlewares = append(m.middlewares, middlewares...)
+	})
+}
+
+func (r *Route) With(middlewares ...Middleware) *Route {
+	r.middlewares = append(r.middlewares, middlewares...)
+	return r
+}
+
+func newRouteInfo(route *Route, pattern string, routeValue reflect.Value) RouteInfo {
+	method, path, _ := strings.Cut(pattern, " ")
+	info := RouteInfo{
+		Pattern:     pattern,
+		Method:      method,
+		Path:        path,
+		OperationID: route.options.OperationID,
+		Tags:        append(route.ZealMux.getTags(), route.options.Tags...),
+		middlewares: slices.Clone(route.middlewares),
+	}
+
+	if routeValue.Kind() == reflect.Interface {
+		return info
+	}
+
+	info.RouteType = routeValue.Type()
+	info.ClaimsType = getDeclaredType(routeValue, HasClaims[any]{}, "Claims")
+	info.ParamsType = getDeclaredType(routeValue, HasParams[any]{}, "Params")
+	info.BodyType = getDeclaredType(routeValue, HasBody[any]{}, "Body")
+	info.ResponseType = getDeclaredType(routeValue, HasResponse[any]{}, "Response")
+
+	return info
+}
+
+func getDeclaredType(routeValue reflect.Value, instance any, methodName string) reflect.Type {
+	field := routeValue.FieldByName(getTypeName(instance))
+	if !field.IsValid() {
+		return nil
+	}
+
+	methodType := field.Addr().MethodByName(methodName).Type()
+	if methodType.NumIn() > 0 {
+		return methodType.In(0)
+	}
+
+	return methodType.Out(0)
+}
+
+func (m *ZealMux) getMiddlewares(info RouteInfo) []Middleware {
+	var middlewares []Middleware
+	for mux := m; mux != nil; mux = mux.parent {
+		middlewares = append(slices.Clone(mux.middlewares), middlewares...)
+	}
+
+	return append(middlewares, info.middlewares...)
+}
+
+func (m *ZealMux) applyMiddleware(info RouteInfo, handler http.Handler) http.Handler {
+	var once sync.Once
+	var chained http.Handler
+
+	return http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		once.Do(func() {
+			chained = handler
+			var middlewares []Middleware
+			m.readRegistry(func() {
+				middlewares = m.getMiddlewares(info)
+			})
+			for i := len(middlewares) - 1; i >= 0; i-- {
+				chained = middlewares[i].Middleware(info, chained)
+			}
+		})
+
+		chained.ServeHTTP(w, request)
+	})
+}
+
+func (r *Route) customizeMiddleware(info RouteInfo, operation *openapi3.Operation) error {
+	for _, middleware := range r.ZealMux.getMiddlewares(info) {
+		customizer, ok := middleware.(OperationCustomizer)
+		if !ok {
+			continue
+		}
+
+		if err := customizer.CustomizeOperation(info, operation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package zeal
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+type MockOptions struct {
+	Seed uint64
+}
+
+func NewMockHandler(mux *ZealMux, options ...MockOptions) http.Handler {
+	var mockOptions MockOptions
+	if len(options) > 0 {
+		mockOptions = options[0]
+	}
+
+	return &mockHandler{options: mockOptions, validator: &requestValidator{mux: mux}}
+}
+
+type mockHandler struct {
+	options   MockOptions
+	validator *requestValidator
+}
+
+func (h *mockHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	router, err := h.validator.getRouter()
+	if err != nil {
+		Problem(w, "Failed to create OpenAPI spec", http.StatusInternalServerError)
+		return
+	}
+
+	route, pathParams, err := router.FindRoute(r)
+	if err != nil {
+		if allowed := getAllowedMethods(router, r); len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			Problem(w, "", http.StatusMethodNotAllowed)
+			return
+		}
+
+		Problem(w, "", http.StatusNotFound)
+		return
+	}
+
+	if err := validateRequest(r, route, pathParams); err != nil {
+		Problem(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	status, response := getMockResponse(route.Operation)
+	if response == nil || response.Content.Get("application/json") == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	// Each operation has its own source, so its responses don't depend on the order of requests
+	hash := fnv.New64a()
+	hash.Write([]byte(route.Method + " " + route.Path))
+	mock := &mockGenerator{random: rand.New(rand.NewPCG(h.options.Seed, hash.Sum64()))}
+
+	body, err := json.Marshal(mock.mediaTypeValue(response.Content.Get("application/json")))
+	if err != nil {
+		Problem(w, "Failed to encode mock response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(body, '\n'))
+}
+
+var mockMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions, http.MethodTrace,
+}
+
+// getAllowedMethods returns the methods documented for the request's path. The router only tells
+// a path without the request's method apart from an unknown path when the path has no params.
+func getAllowedMethods(router routers.Router, r *http.Request) []string {
+	var allowed []string
+	for _, method := range mockMethods {
+		if method == r.Method {
+			continue
+		}
+
+		request := r.Clone(r.Context())
+		request.Method = method
+		if _, _, err := router.FindRoute(request); err == nil {
+			allowed = append(allowed, method)
+		}
+	}
+
+	return allowed
+}
+
+// getMockResponse returns the lowest 2xx status of the operation, or its lowest status if it has no 2xx
+func getMockResponse(operation *openapi3.Operation) (int, *openapi3.Response) {
+	status, lowest := 0, 0
+	for code := range operation.Responses.Map() {
+		value, err := strconv.Atoi(code)
+		if err != nil {
+			continue
+		}
+
+		if lowest == 0 || value < lowest {
+			lowest = value
+		}
+		if value >= 200 && value <= 299 && (status == 0 || value < status) {
+			status = value
+		}
+	}
+
+	if status == 0 {
+		status = lowest
+	}
+	if status == 0 {
+		return http.StatusOK, nil
+	}
+
+	response := operation.Responses.Status(status)
+	if response == nil {
+		return status, nil
+	}
+
+	return status, response.Value
+}
+
+type mockGenerator struct {
+	random *rand.Rand
+}
+
+func (g *mockGenerator) mediaTypeValue(mediaType *openapi3.MediaType) any {
+	if mediaType.Example != nil {
+		return mediaType.Example
+	}
+
+	if len(mediaType.Examples) > 0 {
+		names := getSortedKeys(mediaType.Examples)
+		example := mediaType.Examples[names[g.random.IntN(len(names))]]
+		if example != nil && example.Value != nil {
+			return example.Value.Value
+		}
+	}
+
+	return g.schemaValue(mediaType.Schema, 0)
+}
+
+// schemaValue synthesizes a value which is valid against the schema, apart from patterns, which are ignored
+func (g *mockGenerator) schemaValue(schemaRef *openapi3.SchemaRef, depth int) any {
+	if schemaRef == nil || schemaRef.Value == nil {
+		return nil
+	}
+	schema := schemaRef.Value
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case len(schema.Enum) > 0:
+		return schema.Enum[g.random.IntN(len(schema.Enum))]
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.OneOf) > 0:
+		return g.schemaValue(schema.OneOf[0], depth+1)
+	case len(schema.AnyOf) > 0:
+		return g.schemaValue(schema.AnyOf[0], depth+1)
+	case len(schema.AllOf) > 0:
+		merged := map[string]any{}
+		for _, allOf := range schema.AllOf {
+			if value, ok := g.schemaValue(allOf, depth+1).(map[string]any); ok {
+				for name, property := range value {
+					merged[name] = property
+				}
+			}
+		}
+		return merged
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeObject) || len(schema.Properties) > 0:
+		return g.objectValue(schema, depth)
+	case schema.Type.Is(openapi3.TypeArray):
+		return g.arrayValue(schema, depth)
+	case schema.Type.Is(openapi3.TypeString):
+		return g.stringValue(schema)
+	case schema.Type.Is(openapi3.TypeInteger):
+		return int64(g.numberValue(schema, true))
+	case schema.Type.Is(openapi3.TypeNumber):
+		return g.numberValue(schema, false)
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return g.random.IntN(2) == 1
+	}
+
+	return nil
+}
+
+func (g *mockGenerator) objectValue(schema *openapi3.Schema, depth int) any {
+	value := map[string]any{}
+
+	// Recursive schemas stop at their required properties, so values stay finite
+	for _, name := range getSortedKeys(schema.Properties) {
+		if depth > 4 && !slices.Contains(schema.Required, name) {
+			continue
+		}
+
+		value[name] = g.schemaValue(schema.Properties[name], depth+1)
+	}
+
+	return value
+}
+
+func (g *mockGenerator) arrayValue(schema *openapi3.Schema, depth int) any {
+	length := int(schema.MinItems)
+	if depth <= 4 {
+		length += 1 + g.random.IntN(3)
+	}
+	if schema.MaxItems != nil && uint64(length) > *schema.MaxItems {
+		length = int(*schema.MaxItems)
+	}
+
+	items := make([]any, length)
+	for i := range items {
+		items[i] = g.schemaValue(schema.Items, depth+1)
+	}
+
+	return items
+}
+
+var mockWords = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india", "juliet"}
+
+func (g *mockGenerator) stringValue(schema *openapi3.Schema) any {
+	switch schema.Format {
+	case "date-time":
+		return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(g.random.IntN(365*24)) * time.Hour).Format(time.RFC3339)
+	case "date":
+		return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, g.random.IntN(365)).Format(time.DateOnly)
+	case "uuid":
+		return fmt.Sprintf("%08x-%04x-4%03x-8%03x-%012x", g.random.Uint32(), g.random.IntN(1<<16), g.random.IntN(1<<12), g.random.IntN(1<<12), g.random.Uint64()&(1<<48-1))
+	case "email":
+		return mockWords[g.random.IntN(len(mockWords))] + "@example.com"
+	case "uri", "url":
+		return "https://example.com/" + mockWords[g.random.IntN(len(mockWords))]
+	}
+
+	value := mockWords[g.random.IntN(len(mockWords))]
+	for uint64(len(value)) < schema.MinLength {
+		value += " " + mockWords[g.random.IntN(len(mockWords))]
+	}
+	if schema.MaxLength != nil && uint64(len(value)) > *schema.MaxLength {
+		value = value[:*schema.MaxLength]
+	}
+
+	return value
+}
+
+func (g *mockGenerator) numberValue(schema *openapi3.Schema, isInteger bool) float64 {
+	minimum, maximum := 0.0, 100.0
+	if schema.Min != nil {
+		minimum = *schema.Min
+		if schema.Max == nil {
+			maximum = minimum + 100
+		}
+	}
+	if schema.Max != nil {
+		maximum = *schema.Max
+		if schema.Min == nil {
+			minimum = math.Min(0, maximum-100)
+		}
+	}
+
+	// Exclusive bounds are avoided by staying a step inside them
+	step := math.Min(1, (maximum-minimum)/4)
+	if schema.ExclusiveMin {
+		minimum += step
+	}
+	if schema.ExclusiveMax {
+		maximum -= step
+	}
+
+	// Two decimal places keep mocks readable
+	value := math.Round((minimum+g.random.Float64()*(maximum-minimum))*100) / 100
+	value = math.Max(minimum, math.Min(maximum, value))
+	if isInteger {
+		value = math.Ceil(value)
+		if value > maximum {
+			value = math.Floor(maximum)
+		}
+	}
+
+	// Multiples are taken from within the bounds, keeping the value if none lies between them
+	if schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+		multipleOf := *schema.MultipleOf
+		lowest, highest := math.Ceil(minimum/multipleOf), math.Floor(maximum/multipleOf)
+		if lowest <= highest {
+			value = (lowest + float64(g.random.IntN(int(math.Min(highest-lowest, 1000))+1))) * multipleOf
+		}
+	}
+
+	return value
+}
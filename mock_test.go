@@ -0,0 +1,240 @@
+package zeal
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type mockSize string
+
+func (mockSize) Enum() []mockSize {
+	return []mockSize{"small", "large"}
+}
+
+func newMockTestMux() *ZealMux {
+	mux := NewZealMux(http.NewServeMux())
+
+	var getMenu = NewRoute[struct {
+		Route
+		HasParams[struct {
+			ID int `in:"path"`
+		}]
+		HasResponse[struct {
+			Name   string
+			Size   mockSize
+			Tags   []string
+			Price  float64
+			Stock  int
+			Served bool
+			Dish   struct{ Chef string }
+		}]
+	}](mux)
+	getMenu.HandleFunc("GET /menus/{ID}", func(w http.ResponseWriter, r *http.Request) {})
+
+	var getSpecial = NewRoute[struct {
+		Route
+		HasResponse[struct {
+			Name  string `example:"Steak"`
+			Price float64
+		}]
+	}](mux)
+	getSpecial.HandleFunc("GET /special", func(w http.ResponseWriter, r *http.Request) {})
+
+	type item = struct {
+		Name  string
+		Price float64
+	}
+	var postItem = NewRoute[struct {
+		Route
+		HasBody[item]
+		HasResponse[item]
+	}](mux, RouteOptions{
+		Examples: []RouteExample{{
+			Name:     "juice",
+			Body:     item{Name: "Juice", Price: 1.25},
+			Response: item{Name: "Juice", Price: 1.25},
+		}},
+	})
+	postItem.HandleFunc("POST /items", func(w http.ResponseWriter, r *http.Request) {})
+
+	return mux
+}
+
+func serveMock(handler http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		r.Header.Set("Content-Type", "application/json")
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestMockHandler(t *testing.T) {
+	mux := newMockTestMux()
+	mock := NewMockHandler(mux, MockOptions{Seed: 1})
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedStatus int
+	}{
+		{"documented route", http.MethodGet, "/menus/1", "", http.StatusOK},
+		{"route with body", http.MethodPost, "/items", `{"Name":"Tea","Price":2}`, http.StatusOK},
+		{"invalid path param", http.MethodGet, "/menus/abc", "", http.StatusUnprocessableEntity},
+		{"invalid body", http.MethodPost, "/items", `{"Name":1,"Price":2}`, http.StatusUnprocessableEntity},
+		{"missing body", http.MethodPost, "/items", "", http.StatusUnprocessableEntity},
+		{"unknown path", http.MethodGet, "/orders", "", http.StatusNotFound},
+		{"unknown method", http.MethodDelete, "/menus/1", "", http.StatusMethodNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serveMock(mock, test.method, test.target, test.body)
+			if w.Code != test.expectedStatus {
+				t.Fatalf("expected status %v, received: %v: %s", test.expectedStatus, w.Code, w.Body)
+			}
+
+			if w.Code == http.StatusMethodNotAllowed && w.Header().Get("Allow") != "GET" {
+				t.Fatalf("expected allowed methods GET, received: %v", w.Header().Get("Allow"))
+			}
+
+			if w.Code != http.StatusOK {
+				if contentType := w.Header().Get("Content-Type"); contentType != "application/problem+json" {
+					t.Fatalf("expected content type application/problem+json, received: %v", contentType)
+				}
+			}
+		})
+	}
+}
+
+func TestMockHandlerResponsesMatchTheirSchema(t *testing.T) {
+	mux := newMockTestMux()
+
+	for seed := range uint64(20) {
+		mock := NewMockHandler(mux, MockOptions{Seed: seed})
+		for _, info := range mux.Routes() {
+			target := strings.ReplaceAll(info.Path, "{ID}", "1")
+			body := ""
+			if info.BodyType != nil {
+				body = `{"Name":"Tea","Price":2}`
+			}
+
+			w := serveMock(mock, info.Method, target, body)
+			r := httptest.NewRequest(info.Method, target, strings.NewReader(body))
+			if err := mux.ValidateResponse(info, r, w.Code, w.Header(), w.Body.Bytes()); err != nil {
+				t.Fatalf("expected mock response from %v with seed %v to match its schema, received: %v: %s", info.Pattern, seed, err, w.Body)
+			}
+		}
+	}
+}
+
+func TestMockHandlerIsDeterministic(t *testing.T) {
+	mux := newMockTestMux()
+
+	first := serveMock(NewMockHandler(mux, MockOptions{Seed: 1}), http.MethodGet, "/menus/1", "")
+
+	// Other requests in between don't change the response
+	mock := NewMockHandler(mux, MockOptions{Seed: 1})
+	serveMock(mock, http.MethodGet, "/special", "")
+	serveMock(mock, http.MethodGet, "/menus/2", "")
+	second := serveMock(mock, http.MethodGet, "/menus/1", "")
+
+	if !bytes.Equal(first.Body.Bytes(), second.Body.Bytes()) {
+		t.Fatalf("expected the same response for the same seed, received: %s and %s", first.Body, second.Body)
+	}
+
+	other := serveMock(NewMockHandler(mux, MockOptions{Seed: 2}), http.MethodGet, "/menus/1", "")
+	if bytes.Equal(first.Body.Bytes(), other.Body.Bytes()) {
+		t.Fatalf("expected a different response for a different seed, received: %s", other.Body)
+	}
+}
+
+func TestMockHandlerPrefersExamples(t *testing.T) {
+	mux := newMockTestMux()
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		expected map[string]any
+		ignored  string
+	}{
+		{"route example", http.MethodPost, "/items", `{"Name":"Tea","Price":2}`, map[string]any{"Name": "Juice", "Price": 1.25}, ""},
+		{"field example", http.MethodGet, "/special", "", map[string]any{"Name": "Steak"}, "Price"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for seed := range uint64(10) {
+				w := serveMock(NewMockHandler(mux, MockOptions{Seed: seed}), test.method, test.target, test.body)
+
+				var received map[string]any
+				if err := json.Unmarshal(w.Body.Bytes(), &received); err != nil {
+					t.Fatalf("expected JSON response, received: %s: %v", w.Body, err)
+				}
+				delete(received, test.ignored)
+
+				if len(received) != len(test.expected) {
+					t.Fatalf("expected response %v, received: %v", test.expected, received)
+				}
+				for name, value := range test.expected {
+					if received[name] != value {
+						t.Fatalf("expected response %v, received: %v", test.expected, received)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMockNumberValueKeepsMultiplesWithinBounds(t *testing.T) {
+	float := func(value float64) *float64 { return &value }
+
+	tests := []struct {
+		name        string
+		schema      *openapi3.Schema
+		isInteger   bool
+		hasMultiple bool
+	}{
+		{"minimum", &openapi3.Schema{Min: float(7), MultipleOf: float(5)}, true, true},
+		{"maximum", &openapi3.Schema{Max: float(12), MultipleOf: float(5)}, true, true},
+		{"both bounds", &openapi3.Schema{Min: float(7), Max: float(12), MultipleOf: float(5)}, true, true},
+		{"exclusive bounds", &openapi3.Schema{Min: float(0), Max: float(10), ExclusiveMin: true, ExclusiveMax: true, MultipleOf: float(5)}, true, true},
+		{"exclusive maximum on a multiple", &openapi3.Schema{Min: float(3), Max: float(10), ExclusiveMax: true, MultipleOf: float(5)}, true, true},
+		{"fractional multiple", &openapi3.Schema{Min: float(0.3), Max: float(0.9), ExclusiveMin: true, MultipleOf: float(0.25)}, false, true},
+		{"no multiple between bounds", &openapi3.Schema{Min: float(7), Max: float(9), MultipleOf: float(5)}, true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for seed := range uint64(50) {
+				mock := &mockGenerator{random: rand.New(rand.NewPCG(seed, 0))}
+				value := mock.numberValue(test.schema, test.isInteger)
+
+				if quotient := value / *test.schema.MultipleOf; test.hasMultiple && math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+					t.Fatalf("expected a multiple of %v, received: %v", *test.schema.MultipleOf, value)
+				}
+
+				schema := *test.schema
+				schema.MultipleOf = nil
+				if err := schema.VisitJSON(value); err != nil {
+					t.Fatalf("expected a value within the bounds, received: %v: %v", value, err)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package zeal
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -11,7 +13,19 @@ import (
 
 type ZealMux struct {
 	*http.ServeMux
-	Api *rest.API
+	Api              *rest.API
+	customOperations map[string][]func(*openapi3.Operation) error
+	securitySchemes  map[string]SecurityScheme
+	routes           map[string]string
+	routeInfos       map[string]RouteInfo
+	spec             *liveSpec
+	contractTest     *ContractTestOptions
+	security         openapi3.SecurityRequirements
+	middlewares      []Middleware
+	tags             []string
+	prefix           string
+	parent           *ZealMux
+	errs             *[]error
 }
 
 func NewZealMux(mux *http.ServeMux, apiName ...string) *ZealMux {
@@ -19,7 +33,17 @@ func NewZealMux(mux *http.ServeMux, apiName ...string) *ZealMux {
 	if len(apiName) > 0 {
 		name = apiName[0]
 	}
-	return &ZealMux{ServeMux: mux, Api: rest.NewAPI(name)}
+	api := rest.NewAPI(name, rest.WithApplyCustomSchemaToType(applyCustomSchemaToType))
+	return &ZealMux{
+		ServeMux:         mux,
+		Api:              api,
+		customOperations: make(map[string][]func(*openapi3.Operation) error),
+		securitySchemes:  make(map[string]SecurityScheme),
+		routes:           make(map[string]string),
+		routeInfos:       make(map[string]RouteInfo),
+		spec:             &liveSpec{},
+		errs:             &[]error{},
+	}
 }
 
 type SpecOptions struct {
@@ -30,6 +54,26 @@ type SpecOptions struct {
 }
 
 func NewOpenAPISpec(options SpecOptions) (*openapi3.T, error) {
+	live := options.ZealMux.spec
+	live.mu.Lock()
+	defer live.mu.Unlock()
+
+	unlock := options.ZealMux.lockAncestors()
+	defer unlock()
+
+	// The options are kept for the mux's live spec
+	live.options = options
+	live.options.ZealMux = nil
+	live.version++
+
+	return buildOpenAPISpec(options)
+}
+
+func buildOpenAPISpec(options SpecOptions) (*openapi3.T, error) {
+	if err := options.ZealMux.Validate(); err != nil {
+		return nil, err
+	}
+
 	options.ZealMux.Api.StripPkgPaths = options.StripPkgPaths
 
 	spec, err := options.ZealMux.Api.Spec()
@@ -37,34 +81,55 @@ func NewOpenAPISpec(options SpecOptions) (*openapi3.T, error) {
 		return nil, err
 	}
 
-	spec.Info.Version = options.Version
+	if options.Version != "" {
+		spec.Info.Version = options.Version
+	}
 	spec.Info.Description = options.Description
 
-	for _, schemaRef := range spec.Components.Schemas {
-		for propertyName := range schemaRef.Value.Properties {
-			schemaRef.Value.Required = append(schemaRef.Value.Required, propertyName)
+	for name, scheme := range options.ZealMux.securitySchemes {
+		if spec.Components.SecuritySchemes == nil {
+			spec.Components.SecuritySchemes = make(openapi3.SecuritySchemes)
 		}
+		spec.Components.SecuritySchemes[name] = &openapi3.SecuritySchemeRef{Value: scheme.SecurityScheme}
 	}
 
-	for _, path := range spec.Paths.Map() {
-		prepareForConsumption(path.Connect)
-		prepareForConsumption(path.Delete)
-		prepareForConsumption(path.Get)
-		prepareForConsumption(path.Head)
-		prepareForConsumption(path.Options)
-		prepareForConsumption(path.Patch)
-		prepareForConsumption(path.Post)
-		prepareForConsumption(path.Put)
-		prepareForConsumption(path.Trace)
+	for pathName, path := range spec.Paths.Map() {
+		for method, operation := range path.Operations() {
+			err := options.ZealMux.applyCustomOperation(method, pathName, operation)
+			if err != nil {
+				return nil, err
+			}
+			prepareForConsumption(operation)
+		}
+	}
+
+	for _, customize := range options.ZealMux.spec.customizers {
+		customize(spec)
+	}
+
+	if err := spec.Validate(context.Background()); err != nil {
+		return nil, err
 	}
 
 	return spec, nil
 }
 
-func prepareForConsumption(operation *openapi3.Operation) {
-	if operation == nil {
-		return
+func (m *ZealMux) customizeOperation(method, path string, customize func(*openapi3.Operation) error) {
+	key := method + " " + path
+	m.customOperations[key] = append(m.customOperations[key], customize)
+}
+
+func (m *ZealMux) applyCustomOperation(method, path string, operation *openapi3.Operation) error {
+	for _, customize := range m.customOperations[method+" "+path] {
+		if err := customize(operation); err != nil {
+			return fmt.Errorf("failed to customize operation %v %v: %w", method, path, err)
+		}
 	}
+
+	return nil
+}
+
+func prepareForConsumption(operation *openapi3.Operation) {
 	removeDefaultResponses(operation)
 	requireRequestBody(operation)
 }
@@ -87,13 +152,26 @@ func requireRequestBody(operation *openapi3.Operation) {
 	operation.RequestBody.Value.Required = true
 }
 
+// ServeSwaggerUI serves the Swagger UI at the path. If the spec is nil, the mux's live spec is served.
 func ServeSwaggerUI(mux *ZealMux, openAPISpec *openapi3.T, path string) error {
 	ui, err := swaggerui.New(openAPISpec)
 	if err != nil {
 		return err
 	}
 
-	mux.Handle(path, ui)
+	specHandler, err := newSpecHandler(mux, openAPISpec, encodeSpecJSON, "application/json")
+	if err != nil {
+		return err
+	}
+
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/swagger.json") {
+			specHandler.ServeHTTP(w, r)
+			return
+		}
+
+		ui.ServeHTTP(w, r)
+	}))
 
 	return nil
 }
@@ -104,19 +182,39 @@ func StripPrefix(prefix string, h *ZealMux) *ZealMux {
 }
 
 func (m *ZealMux) Handle(pattern string, handler http.Handler) {
+	pattern = m.prefixPattern(pattern)
+
 	switch sHandler := handler.(type) {
 	case *ZealMux:
-		for _, methodToRoute := range sHandler.Api.Routes {
-			for _, route := range methodToRoute {
-				mergeRoute(strings.TrimSuffix(pattern, "/"), m.Api, route)
-			}
+		// Groups already share the registry of their mux
+		if sHandler.spec != m.spec {
+			sHandler.updateRegistry(func() {
+				sHandler.parent = m
+				sHandler.spec.mounts = append(sHandler.spec.mounts, &mount{
+					parent:     m,
+					child:      sHandler,
+					prefix:     strings.TrimSuffix(pattern, "/"),
+					claimed:    make(map[string]bool),
+					customized: make(map[string]int),
+				})
+			})
 		}
 		m.ServeMux.Handle(pattern, sHandler)
 	default:
-		m.ServeMux.Handle(pattern, sHandler)
+		method, path, _ := strings.Cut(pattern, " ")
+		if path == "" {
+			method, path = "", pattern
+		}
+		info := RouteInfo{Pattern: pattern, Method: method, Path: path, Tags: m.getTags()}
+		m.ServeMux.Handle(pattern, m.applyMiddleware(info, m.requireSecurity(sHandler)))
 	}
 }
 
+// HandleFunc registers a plain handler function, with the prefix, middleware and security of the mux
+func (m *ZealMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.Handle(pattern, http.HandlerFunc(handler))
+}
+
 func mergeRoute(prefix string, api *rest.API, r *rest.Route) {
 	toUpdate := api.Route(string(r.Method), prefix+string(r.Pattern))
 	mergeMap(toUpdate.Params.Path, r.Params.Path)
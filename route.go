@@ -6,9 +6,11 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
 )
 
-func NewRoute[T_Route http.Handler](mux *ZealMux) *T_Route {
+func NewRoute[T_Route http.Handler](mux *ZealMux, options ...RouteOptions) *T_Route {
 	var route T_Route
 
 	routePtrValue := reflect.New(reflect.TypeOf(route))
@@ -27,12 +29,62 @@ func NewRoute[T_Route http.Handler](mux *ZealMux) *T_Route {
 		zealMux.Set(reflect.ValueOf(mux))
 	}
 
+	if len(options) > 0 {
+		route := routePtrValue.Interface()
+		if routeMux.IsValid() {
+			route = routeMux.Addr().Interface()
+		}
+		route.(*Route).options = options[0]
+	}
+
 	return routePtrValue.Interface().(*T_Route)
 }
 
 type Route struct {
 	*ZealMux
 	routeDefinition *any
+	options         RouteOptions
+	middlewares     []Middleware
+}
+
+type RouteOptions struct {
+	OperationID  string
+	Summary      string
+	Description  string
+	Tags         []string
+	Deprecated   bool
+	ExternalDocs *openapi3.ExternalDocs
+	Examples     []RouteExample
+	Security     openapi3.SecurityRequirements
+}
+
+func (options RouteOptions) customizeOperation(operation *openapi3.Operation) error {
+	if options.OperationID != "" {
+		operation.OperationID = options.OperationID
+	}
+
+	if options.Summary != "" {
+		operation.Summary = options.Summary
+	}
+
+	if options.Description != "" {
+		operation.Description = options.Description
+	}
+
+	if options.ExternalDocs != nil {
+		operation.ExternalDocs = options.ExternalDocs
+	}
+
+	operation.Tags = append(operation.Tags, options.Tags...)
+	operation.Deprecated = operation.Deprecated || options.Deprecated
+
+	for _, example := range options.Examples {
+		if err := example.customizeOperation(operation); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (r *Route) Validate(routeDefinition ...*any) *any {
@@ -45,112 +97,101 @@ func (r *Route) Validate(routeDefinition ...*any) *any {
 
 type HasParams[T_Params any] struct {
 	request *http.Request
+	params  T_Params
+	plan    paramsPlan
+	bind    func(*http.Request, *T_Params) error
 }
 
 func (p *HasParams[T_Params]) Params() T_Params {
-	var params T_Params
-	paramsType := reflect.TypeOf(params)
-	if paramsType == nil {
-		return params
-	}
-
-	paramsValue := reflect.New(paramsType).Elem()
-
-	for i := 0; i < paramsType.NumField(); i++ {
-		field := paramsType.Field(i)
-		structField := paramsValue.FieldByName(field.Name)
-		if structField.CanSet() {
-			rawParamValue := p.request.PathValue(field.Name)
-			if rawParamValue == "" {
-				rawParamValue = p.request.URL.Query().Get(field.Name)
-			}
-			paramValue, _ := parsePrimitive(rawParamValue, field.Type)
-			structField.Set(reflect.ValueOf(paramValue))
-		}
+	return p.params
+}
+
+func (p *HasParams[T_Params]) Validate(request *http.Request) (T_Params, error) {
+	if p.plan == nil {
+		p.compileBinding()
 	}
 
-	params = paramsValue.Interface().(T_Params)
+	err := p.bindRequest(request)
 
-	return params
+	return p.params, err
 }
 
-func (p *HasParams[T_Params]) Validate(request *http.Request) (T_Params, error) {
+func (p *HasParams[T_Params]) compileBinding() {
+	p.plan = newParamsPlan(reflect.TypeFor[T_Params]())
+	p.bind = getParamsBinder[T_Params]()
+}
+
+func (p *HasParams[T_Params]) bindRequest(request *http.Request) error {
 	p.request = request
 
 	var params T_Params
-	paramsType := reflect.TypeOf(params)
-	if paramsType == nil {
-		return params, nil
-	}
-
-	paramsValue := reflect.New(paramsType).Elem()
-
-	var error error
-
-	for i := 0; i < paramsType.NumField(); i++ {
-		field := paramsType.Field(i)
-		structField := paramsValue.FieldByName(field.Name)
-		if structField.CanSet() {
-			rawParamValue := p.request.PathValue(field.Name)
-			if rawParamValue == "" {
-				rawParamValue = p.request.URL.Query().Get(field.Name)
-			}
-			paramValue, err := parsePrimitive(rawParamValue, field.Type)
-			if err != nil {
-				error = err
-				continue
-			}
-
-			structField.Set(reflect.ValueOf(paramValue))
-		}
-	}
+	p.params = params
 
-	params = paramsValue.Interface().(T_Params)
+	if p.bind != nil {
+		return p.bind(request, &p.params)
+	}
 
-	return params, error
+	return p.plan.bind(request, reflect.ValueOf(&p.params).Elem())
 }
 
 type HasBody[T_Body any] struct {
-	request *http.Request
+	request     *http.Request
+	body        T_Body
+	isDecodable bool
+	decode      func([]byte, *T_Body) error
 }
 
 func (b *HasBody[T_Body]) Body() T_Body {
-	var body T_Body
-	defer b.request.Body.Close()
-	json.NewDecoder(b.request.Body).Decode(&body)
-	return body
+	return b.body
 }
 
 func (b *HasBody[T_Body]) Validate(request *http.Request) (T_Body, error) {
+	b.compileBinding()
+	err := b.bindRequest(request)
+	return b.body, err
+}
+
+func (b *HasBody[T_Body]) compileBinding() {
+	b.isDecodable = reflect.TypeFor[T_Body]().Kind() != reflect.Interface
+	b.decode = getBodyDecoder[T_Body]()
+}
+
+func (b *HasBody[T_Body]) bindRequest(request *http.Request) error {
 	b.request = request
 
 	var body T_Body
-	bodyType := reflect.TypeOf(body)
-	if bodyType == nil {
-		return body, nil
+	b.body = body
+
+	if !b.isDecodable {
+		return nil
 	}
 
 	defer b.request.Body.Close()
 	bodyBytes, err := io.ReadAll(b.request.Body)
 	if err != nil {
-		return body, nil
+		return nil
 	}
 
 	// Replace the original body with a new reader based on the read bytes
 	b.request.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 
+	if b.decode != nil {
+		return b.decode(bodyBytes, &b.body)
+	}
+
+	return decodeJSONBody(bodyBytes, &b.body)
+}
+
+func decodeJSONBody[T_Body any](bodyBytes []byte, body *T_Body) error {
 	decoder := json.NewDecoder(bytes.NewReader(bodyBytes))
 	decoder.DisallowUnknownFields() // Enable strict mode
 
-	if err := decoder.Decode(&body); err != nil {
-		return body, err
-	}
-
-	return body, nil
+	return decoder.Decode(body)
 }
 
 type HasResponse[T_Response any] struct {
 	responseWriter *http.ResponseWriter
+	encode         func([]byte, *T_Response) ([]byte, error)
 }
 
 func (r *HasResponse[T_Response]) Response(data T_Response, status ...int) error {
@@ -160,6 +201,10 @@ func (r *HasResponse[T_Response]) Response(data T_Response, status ...int) error
 		(*r.responseWriter).WriteHeader(status[0])
 	}
 
+	if r.encode != nil {
+		return r.writeEncoded(data)
+	}
+
 	if err := json.NewEncoder((*r.responseWriter)).Encode(data); err != nil {
 		http.Error((*r.responseWriter), http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return err
@@ -168,7 +213,29 @@ func (r *HasResponse[T_Response]) Response(data T_Response, status ...int) error
 	return nil
 }
 
+func (r *HasResponse[T_Response]) writeEncoded(data T_Response) error {
+	encoded, err := r.encode(nil, &data)
+	if err != nil {
+		http.Error((*r.responseWriter), http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return err
+	}
+
+	if _, err := (*r.responseWriter).Write(append(encoded, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *HasResponse[T_Response]) Validate(responseWriter *http.ResponseWriter) {
+	r.bindResponse(responseWriter)
+}
+
+func (r *HasResponse[T_Response]) compileBinding() {
+	r.encode = getResponseEncoder[T_Response]()
+}
+
+func (r *HasResponse[T_Response]) bindResponse(responseWriter *http.ResponseWriter) {
 	r.responseWriter = responseWriter
 }
 
@@ -1,51 +1,56 @@
 package zeal
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/a-h/rest"
+	"github.com/getkin/kin-openapi/openapi3"
 )
 
-func registerRoute(mux *ZealMux, pattern string, routeType reflect.Value) {
-	route, err := newRoute(pattern, mux)
+func registerRoute(zealRoute *Route, info RouteInfo) {
+	mux := zealRoute.ZealMux
+	route, err := newRoute(info.Pattern, mux)
 	if err != nil {
-		fmt.Println(err)
+		mux.addError(&RouteError{Pattern: info.Pattern, Err: err})
 		return
 	}
+	mux.routeInfos[string(route.Method)+" "+string(route.Pattern)] = info
 
-	if routeType.Kind() == reflect.Interface {
-		registerResponse(route, nil)
-		return
+	if info.ParamsType != nil {
+		if err := registerParams(route, info.Pattern, info.ParamsType); err != nil {
+			mux.addError(err)
+		}
+		for name, params := range getTypeExamples(info.ParamsType) {
+			registerExample(mux, route, RouteExample{Name: name, Params: params})
+		}
 	}
 
-	paramsTypeName := getTypeName(HasParams[any]{})
-	paramsField := routeType.FieldByName(paramsTypeName)
-	if paramsField.IsValid() {
-		method := paramsField.Addr().MethodByName("Params")
-		if err := registerParams(route, pattern, method.Type().Out(0)); err != nil {
-			fmt.Println(err)
+	if info.BodyType != nil {
+		registerBody(route, info.BodyType)
+		for name, body := range getTypeExamples(info.BodyType) {
+			registerExample(mux, route, RouteExample{Name: name, Body: body})
 		}
 	}
 
-	bodyTypeName := getTypeName(HasBody[any]{})
-	bodyField := routeType.FieldByName(bodyTypeName)
-	if bodyField.IsValid() {
-		method := bodyField.Addr().MethodByName("Body")
-		registerBody(route, method.Type().Out(0))
+	registerResponse(route, info.ResponseType)
+	for name, response := range getTypeExamples(info.ResponseType) {
+		registerExample(mux, route, RouteExample{Name: name, Response: response})
 	}
 
-	responseTypeName := getTypeName(HasResponse[any]{})
-	responseField := routeType.FieldByName(responseTypeName)
-	if responseField.IsValid() {
-		method := responseField.Addr().MethodByName("Response")
-		registerResponse(route, method.Type().In(0))
-	} else {
-		registerResponse(route, nil)
-	}
+	mux.customizeOperation(string(route.Method), string(route.Pattern), mux.customizeTags)
+	mux.customizeOperation(string(route.Method), string(route.Pattern), zealRoute.options.customizeOperation)
+	mux.customizeOperation(string(route.Method), string(route.Pattern), zealRoute.customizeSecurity)
+	mux.customizeOperation(string(route.Method), string(route.Pattern), func(operation *openapi3.Operation) error {
+		return zealRoute.customizeMiddleware(info, operation)
+	})
 }
 
 func newRoute(pattern string, mux *ZealMux) (*rest.Route, error) {
@@ -53,6 +58,7 @@ func newRoute(pattern string, mux *ZealMux) (*rest.Route, error) {
 	if !found {
 		return nil, fmt.Errorf("expected URL pattern with HTTP method, received: %v", pattern)
 	}
+	path = getDocumentedPath(path)
 
 	var route *rest.Route
 
@@ -89,21 +95,38 @@ func registerParams(route *rest.Route, pattern string, paramsType reflect.Type)
 
 	pathParams, err := getPathParams(pattern)
 	if err != nil {
-		return err
+		return &RouteError{Pattern: pattern, Err: err}
 	}
 
+	var errs []error
 	for i := 0; i < paramsType.NumField(); i++ {
 		field := paramsType.Field(i)
 		primitiveSchemaType, err := getPrimitiveSchemaType(field.Type.Kind())
 		if err != nil {
-			return err
+			errs = append(errs, &RouteError{Pattern: pattern, Field: field.Name, Err: err})
+			continue
+		}
+
+		description := field.Tag.Get("description")
+		deprecated, _ := strconv.ParseBool(field.Tag.Get("deprecated"))
+		rawExample, hasExample := field.Tag.Lookup("example")
+		applyCustomSchema := func(parameter *openapi3.Parameter) {
+			parameter.Deprecated = deprecated
+			if hasExample {
+				parameter.Schema.Value.Example = parseExample(rawExample, field.Type)
+			}
 		}
 
 		pathParam, isPathParam := pathParams[field.Name]
 		if isPathParam {
 			route.HasPathParameter(
 				field.Name,
-				rest.PathParam{Type: primitiveSchemaType, Regexp: pathParam.Regexp},
+				rest.PathParam{
+					Description:       description,
+					Type:              primitiveSchemaType,
+					Regexp:            pathParam.Regexp,
+					ApplyCustomSchema: applyCustomSchema,
+				},
 			)
 			continue
 		}
@@ -111,14 +134,16 @@ func registerParams(route *rest.Route, pattern string, paramsType reflect.Type)
 		route.HasQueryParameter(
 			field.Name,
 			rest.QueryParam{
-				Type:       primitiveSchemaType,
-				Required:   true,
-				AllowEmpty: false,
+				Description:       description,
+				Type:              primitiveSchemaType,
+				Required:          true,
+				AllowEmpty:        false,
+				ApplyCustomSchema: applyCustomSchema,
 			},
 		)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 func getPathParams(pattern string) (map[string]rest.PathParam, error) {
@@ -163,7 +188,7 @@ func getURLPathParamPlaceholder(urlSlug string) (urlPathParamPlaceholder, error)
 	}
 
 	parts := strings.SplitN(urlSlug[1:len(urlSlug)-1], ":", 2)
-	placeholder.name = parts[0]
+	placeholder.name = strings.TrimSuffix(parts[0], "...")
 	if len(parts) > 1 {
 		placeholder.validationRegexp = parts[1]
 	}
@@ -196,6 +221,10 @@ func registerBody(route *rest.Route, bodyType reflect.Type) {
 	route.HasRequestModel(rest.Model{Type: bodyType})
 }
 
+func registerExample(mux *ZealMux, route *rest.Route, example RouteExample) {
+	mux.customizeOperation(string(route.Method), string(route.Pattern), example.customizeOperation)
+}
+
 func registerResponse(route *rest.Route, responseType reflect.Type) {
 	if responseType == nil {
 		route.HasResponseModel(http.StatusOK, rest.Model{Type: reflect.TypeOf("")})
@@ -204,3 +233,127 @@ func registerResponse(route *rest.Route, responseType reflect.Type) {
 
 	route.HasResponseModel(http.StatusOK, rest.Model{Type: responseType})
 }
+
+func applyCustomSchemaToType(t reflect.Type, schema *openapi3.Schema) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		if isReferencedSchema(schema) {
+			schema.Nullable = false
+		}
+	case reflect.Struct:
+		applyFieldSchemas(t, schema)
+	}
+
+	if enum := getTypeEnum(t); len(enum) > 0 {
+		schema.Enum = enum
+	}
+}
+
+func getTypeEnum(enumType reflect.Type) []any {
+	method := reflect.New(enumType).MethodByName("Enum")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 || method.Type().Out(0).Kind() != reflect.Slice {
+		return nil
+	}
+
+	// Values are converted to their JSON form, as they are in specs which are loaded
+	encoded, err := json.Marshal(method.Call([]reflect.Value{})[0].Interface())
+	if err != nil {
+		return nil
+	}
+
+	var enum []any
+	if err := json.Unmarshal(encoded, &enum); err != nil {
+		return nil
+	}
+
+	return enum
+}
+
+func applyFieldSchemas(structType reflect.Type, schema *openapi3.Schema) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		if field.Tag.Get("json") == "-" {
+			delete(schema.Properties, "-")
+			schema.Required = removePropertyName(schema.Required, "-")
+			continue
+		}
+
+		propertyName := getJSONFieldName(field)
+		propertySchema, ok := schema.Properties[propertyName]
+		if !ok {
+			continue
+		}
+
+		schema.Properties[propertyName] = getFieldSchemaRef(field, propertySchema)
+
+		if !isFieldRequired(field) {
+			schema.Required = removePropertyName(schema.Required, propertyName)
+		} else if !slices.Contains(schema.Required, propertyName) {
+			schema.Required = append(schema.Required, propertyName)
+		}
+	}
+}
+
+func getJSONFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+func isFieldRequired(field reflect.StructField) bool {
+	required, err := strconv.ParseBool(field.Tag.Get("required"))
+	if err == nil {
+		return required
+	}
+
+	_, jsonOptions, _ := strings.Cut(field.Tag.Get("json"), ",")
+	hasOmitEmpty := slices.Contains(strings.Split(jsonOptions, ","), "omitempty")
+	isPointer := field.Type.Kind() == reflect.Pointer
+
+	return !hasOmitEmpty && !isPointer
+}
+
+func getFieldSchemaRef(field reflect.StructField, schemaRef *openapi3.SchemaRef) *openapi3.SchemaRef {
+	isPointer := field.Type.Kind() == reflect.Pointer
+	description := field.Tag.Get("description")
+	deprecated, _ := strconv.ParseBool(field.Tag.Get("deprecated"))
+	rawExample, hasExample := field.Tag.Lookup("example")
+	if !isPointer && description == "" && !deprecated && !hasExample {
+		return schemaRef
+	}
+
+	if schemaRef.Ref != "" {
+		schemaRef = openapi3.NewSchemaRef("", &openapi3.Schema{AllOf: openapi3.SchemaRefs{schemaRef}})
+	}
+
+	if description != "" {
+		schemaRef.Value.Description = description
+	}
+
+	if hasExample {
+		schemaRef.Value.Example = parseExample(rawExample, field.Type)
+	}
+
+	schemaRef.Value.Nullable = schemaRef.Value.Nullable || isPointer
+	schemaRef.Value.Deprecated = schemaRef.Value.Deprecated || deprecated
+
+	return schemaRef
+}
+
+func isReferencedSchema(schema *openapi3.Schema) bool {
+	isObject := schema.Type.Is(openapi3.TypeObject) && schema.AdditionalProperties.Schema == nil
+	return isObject || len(schema.Enum) > 0
+}
+
+func removePropertyName(propertyNames []string, propertyName string) []string {
+	return slices.DeleteFunc(propertyNames, func(name string) bool {
+		return name == propertyName
+	})
+}
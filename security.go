@@ -0,0 +1,269 @@
+package zeal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+type Authenticator interface {
+	Authenticate(r *http.Request, credential string, scopes []string) (context.Context, error)
+}
+
+type AuthenticatorFunc func(r *http.Request, credential string, scopes []string) (context.Context, error)
+
+func (f AuthenticatorFunc) Authenticate(r *http.Request, credential string, scopes []string) (context.Context, error) {
+	return f(r, credential, scopes)
+}
+
+type SecurityScheme struct {
+	*openapi3.SecurityScheme
+	Authenticator Authenticator
+}
+
+func NewBearerScheme(bearerFormat string, authenticator Authenticator) SecurityScheme {
+	scheme := openapi3.NewSecurityScheme().WithType("http").WithScheme("bearer")
+	if bearerFormat != "" {
+		scheme = scheme.WithBearerFormat(bearerFormat)
+	}
+
+	return SecurityScheme{SecurityScheme: scheme, Authenticator: authenticator}
+}
+
+func NewBasicScheme(authenticator Authenticator) SecurityScheme {
+	scheme := openapi3.NewSecurityScheme().WithType("http").WithScheme("basic")
+	return SecurityScheme{SecurityScheme: scheme, Authenticator: authenticator}
+}
+
+func NewAPIKeyScheme(in, name string, authenticator Authenticator) SecurityScheme {
+	scheme := openapi3.NewSecurityScheme().WithType("apiKey").WithIn(in).WithName(name)
+	return SecurityScheme{SecurityScheme: scheme, Authenticator: authenticator}
+}
+
+func NewOAuth2Scheme(flows *openapi3.OAuthFlows, authenticator Authenticator) SecurityScheme {
+	scheme := openapi3.NewSecurityScheme().WithType("oauth2")
+	scheme.Flows = flows
+	return SecurityScheme{SecurityScheme: scheme, Authenticator: authenticator}
+}
+
+func NewOpenIDConnectScheme(url string, authenticator Authenticator) SecurityScheme {
+	scheme := openapi3.NewOIDCSecurityScheme(url)
+	return SecurityScheme{SecurityScheme: scheme, Authenticator: authenticator}
+}
+
+func (m *ZealMux) AddSecurityScheme(name string, scheme SecurityScheme) {
+	m.updateRegistry(func() {
+		m.securitySchemes[name] = scheme
+	})
+}
+
+func (m *ZealMux) RequireSecurity(requirements ...openapi3.SecurityRequirement) {
+	m.updateRegistry(func() {
+		m.security = append(openapi3.SecurityRequirements{}, requirements...)
+	})
+}
+
+func (m *ZealMux) getSecurityScheme(name string) (SecurityScheme, bool) {
+	for mux := m; mux != nil; mux = mux.parent {
+		scheme, ok := mux.securitySchemes[name]
+		if ok {
+			return scheme, true
+		}
+	}
+
+	return SecurityScheme{}, false
+}
+
+func (m *ZealMux) getSecurity() openapi3.SecurityRequirements {
+	for mux := m; mux != nil; mux = mux.parent {
+		if mux.security != nil {
+			return mux.security
+		}
+	}
+
+	return nil
+}
+
+func (r *Route) getSecurity() openapi3.SecurityRequirements {
+	if r.options.Security != nil {
+		return r.options.Security
+	}
+
+	return r.ZealMux.getSecurity()
+}
+
+func (r *Route) customizeSecurity(operation *openapi3.Operation) error {
+	security := r.getSecurity()
+	if security == nil {
+		return nil
+	}
+
+	for _, requirement := range security {
+		for name := range requirement {
+			if _, ok := r.ZealMux.getSecurityScheme(name); !ok {
+				return fmt.Errorf("expected declared security scheme, received: %v", name)
+			}
+		}
+	}
+
+	operation.Security = &security
+	if len(security) > 0 {
+		operation.AddResponse(http.StatusUnauthorized, newProblemResponse(http.StatusUnauthorized))
+		operation.AddResponse(http.StatusForbidden, newProblemResponse(http.StatusForbidden))
+	}
+
+	return nil
+}
+
+func (r *Route) authenticate(request *http.Request) (*http.Request, error) {
+	return r.ZealMux.authenticate(request, r.getSecurity)
+}
+
+// requireSecurity enforces the mux's security on a plain handler, as the wrappers of routes do
+func (m *ZealMux) requireSecurity(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, err := m.authenticate(r, m.getSecurity)
+		if err != nil {
+			writeAuthError(w, err)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (m *ZealMux) authenticate(request *http.Request, getSecurity func() openapi3.SecurityRequirements) (*http.Request, error) {
+	var security openapi3.SecurityRequirements
+	var schemes map[string]SecurityScheme
+	m.readRegistry(func() {
+		security = getSecurity()
+		schemes = m.getSecuritySchemes(security)
+	})
+	if len(security) == 0 {
+		return request, nil
+	}
+
+	var authErr error
+	for _, requirement := range security {
+		authenticated, err := authenticateRequirement(request, requirement, schemes)
+		if err == nil {
+			return authenticated, nil
+		}
+
+		if authErr == nil || errors.Is(err, ErrForbidden) {
+			authErr = err
+		}
+	}
+
+	return request, authErr
+}
+
+// getSecuritySchemes looks up the schemes of the requirements, so they can be used once the registry is unlocked
+func (m *ZealMux) getSecuritySchemes(security openapi3.SecurityRequirements) map[string]SecurityScheme {
+	if len(security) == 0 {
+		return nil
+	}
+
+	schemes := make(map[string]SecurityScheme)
+	for _, requirement := range security {
+		for name := range requirement {
+			if scheme, ok := m.getSecurityScheme(name); ok {
+				schemes[name] = scheme
+			}
+		}
+	}
+
+	return schemes
+}
+
+func authenticateRequirement(request *http.Request, requirement openapi3.SecurityRequirement, schemes map[string]SecurityScheme) (*http.Request, error) {
+	for name, scopes := range requirement {
+		scheme, ok := schemes[name]
+		if !ok {
+			return request, fmt.Errorf("%w: undeclared security scheme %v", ErrUnauthenticated, name)
+		}
+
+		credential, ok := scheme.getCredential(request)
+		if !ok {
+			return request, fmt.Errorf("%w: missing credentials for security scheme %v", ErrUnauthenticated, name)
+		}
+
+		if scheme.Authenticator == nil {
+			return request, fmt.Errorf("%w: no authenticator for security scheme %v", ErrUnauthenticated, name)
+		}
+
+		ctx, err := scheme.Authenticator.Authenticate(request, credential, scopes)
+		if err != nil {
+			return request, err
+		}
+
+		if ctx != nil {
+			request = request.WithContext(ctx)
+		}
+	}
+
+	return request, nil
+}
+
+func (scheme SecurityScheme) getCredential(r *http.Request) (string, bool) {
+	switch scheme.Type {
+	case "http":
+		return getAuthorizationCredential(r, scheme.Scheme)
+	case "oauth2", "openIdConnect":
+		return getAuthorizationCredential(r, "bearer")
+	case "apiKey":
+		return getAPIKeyCredential(r, scheme.In, scheme.Name)
+	default:
+		return "", false
+	}
+}
+
+func getAuthorizationCredential(r *http.Request, authScheme string) (string, bool) {
+	authorization := r.Header.Get("Authorization")
+	prefix, credential, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(prefix, authScheme) {
+		return "", false
+	}
+
+	credential = strings.TrimSpace(credential)
+
+	return credential, credential != ""
+}
+
+func getAPIKeyCredential(r *http.Request, in, name string) (string, bool) {
+	var credential string
+
+	switch in {
+	case openapi3.ParameterInHeader:
+		credential = r.Header.Get(name)
+	case openapi3.ParameterInQuery:
+		credential = r.URL.Query().Get(name)
+	case openapi3.ParameterInCookie:
+		cookie, err := r.Cookie(name)
+		if err != nil {
+			return "", false
+		}
+		credential = cookie.Value
+	}
+
+	return credential, credential != ""
+}
+
+// writeAuthError sends a fixed detail, since the cause of the error can hold details of keys and tokens
+func writeAuthError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrForbidden) {
+		Problem(w, "insufficient permissions", http.StatusForbidden)
+		return
+	}
+
+	Problem(w, "invalid credentials", http.StatusUnauthorized)
+}
@@ -0,0 +1,88 @@
+package zeal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func newTestBearerScheme() SecurityScheme {
+	return NewBearerScheme("", AuthenticatorFunc(func(r *http.Request, credential string, scopes []string) (context.Context, error) {
+		if credential != "secret" {
+			return nil, ErrUnauthenticated
+		}
+
+		return r.Context(), nil
+	}))
+}
+
+func TestRequireSecurityCoversPlainHandlers(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	mux.AddSecurityScheme("bearer", newTestBearerScheme())
+	mux.RequireSecurity(openapi3.SecurityRequirement{"bearer": {}})
+	mux.HandleFunc("GET /top", func(w http.ResponseWriter, r *http.Request) {})
+
+	public := mux.Group("/public", GroupOptions{Security: openapi3.SecurityRequirements{}})
+	public.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		target        string
+		authorization string
+		status        int
+	}{
+		{"/top", "", http.StatusUnauthorized},
+		{"/top", "Bearer wrong", http.StatusUnauthorized},
+		{"/top", "Bearer secret", http.StatusOK},
+		{"/public/health", "", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.target, nil)
+		if test.authorization != "" {
+			r.Header.Set("Authorization", test.authorization)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		if w.Code != test.status {
+			t.Errorf("expected status %v for %v with %q, received: %v", test.status, test.target, test.authorization, w.Code)
+		}
+	}
+}
+
+func TestGroupSecurityCoversPlainHandlers(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	mux.AddSecurityScheme("bearer", newTestBearerScheme())
+	admin := mux.Group("/admin", GroupOptions{Security: openapi3.SecurityRequirements{{"bearer": {}}}})
+	admin.HandleFunc("GET /secret", func(w http.ResponseWriter, r *http.Request) {})
+	admin.Handle("GET /files/", http.FileServerFS(fstest.MapFS{"report.txt": {Data: []byte("report")}}))
+	mux.HandleFunc("GET /open", func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		target        string
+		authorization string
+		status        int
+	}{
+		{"/admin/secret", "", http.StatusUnauthorized},
+		{"/admin/secret", "Bearer secret", http.StatusOK},
+		{"/admin/files/", "", http.StatusUnauthorized},
+		{"/open", "", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.target, nil)
+		if test.authorization != "" {
+			r.Header.Set("Authorization", test.authorization)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		if w.Code != test.status {
+			t.Errorf("expected status %v for %v with %q, received: %v", test.status, test.target, test.authorization, w.Code)
+		}
+	}
+}
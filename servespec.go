@@ -0,0 +1,184 @@
+package zeal
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/invopop/yaml"
+)
+
+type ServeSpecOptions struct {
+	// Document adds the spec endpoints themselves to the spec
+	Document bool
+}
+
+// ServeSpec serves the spec at openapi.json and openapi.yaml under the pattern, such as "GET /docs/".
+// If the spec is nil, the mux's live spec is served.
+func ServeSpec(mux *ZealMux, openAPISpec *openapi3.T, pattern string, options ...ServeSpecOptions) error {
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		method, path = http.MethodGet, pattern
+	}
+	path = strings.TrimSuffix(path, "/")
+
+	if len(options) > 0 && options[0].Document {
+		documentedPath := mux.prefix + path
+		if openAPISpec == nil {
+			mux.customizeSpec(func(spec *openapi3.T) {
+				documentSpecEndpoints(spec, documentedPath)
+			})
+		} else {
+			documentSpecEndpoints(openAPISpec, documentedPath)
+		}
+	}
+
+	jsonHandler, err := newSpecHandler(mux, openAPISpec, encodeSpecJSON, "application/json")
+	if err != nil {
+		return err
+	}
+
+	yamlHandler, err := newSpecHandler(mux, openAPISpec, encodeSpecYAML, "application/yaml")
+	if err != nil {
+		return err
+	}
+
+	mux.Handle(method+" "+path+"/openapi.json", jsonHandler)
+	mux.Handle(method+" "+path+"/openapi.yaml", yamlHandler)
+
+	return nil
+}
+
+func encodeSpecJSON(openAPISpec *openapi3.T) ([]byte, error) {
+	return json.MarshalIndent(openAPISpec, "", "  ")
+}
+
+func encodeSpecYAML(openAPISpec *openapi3.T) ([]byte, error) {
+	specJSON, err := encodeSpecJSON(openAPISpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(specJSON)
+}
+
+func documentSpecEndpoints(openAPISpec *openapi3.T, path string) {
+	if openAPISpec.Paths == nil {
+		openAPISpec.Paths = openapi3.NewPaths()
+	}
+
+	for file, contentType := range map[string]string{"openapi.json": "application/json", "openapi.yaml": "application/yaml"} {
+		responses := openapi3.NewResponses(openapi3.WithStatus(http.StatusOK, &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("The OpenAPI spec").
+				WithContent(openapi3.NewContentWithSchema(openapi3.NewObjectSchema(), []string{contentType})),
+		}))
+
+		format := strings.ToUpper(strings.TrimPrefix(file, "openapi."))
+		operation := openapi3.NewOperation()
+		operation.OperationID = "getOpenAPI" + format
+		operation.Summary = "Get the OpenAPI spec as " + format
+		operation.Responses = responses
+
+		openAPISpec.Paths.Set(path+"/"+file, &openapi3.PathItem{Get: operation})
+	}
+}
+
+type staticHandler struct {
+	contentType string
+	body        []byte
+	gzipBody    []byte
+	etag        string
+	gzipETag    string
+}
+
+func newStaticHandler(body []byte, contentType string) (*staticHandler, error) {
+	var gzipBody bytes.Buffer
+	writer := gzip.NewWriter(&gzipBody)
+	if _, err := writer.Write(body); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(body)
+	etag := hex.EncodeToString(hash[:16])
+
+	return &staticHandler{
+		contentType: contentType,
+		body:        body,
+		gzipBody:    gzipBody.Bytes(),
+		etag:        `"` + etag + `"`,
+		gzipETag:    `"` + etag + `-gzip"`,
+	}, nil
+}
+
+func (h *staticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, etag := h.body, h.etag
+	if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		body, etag = h.gzipBody, h.gzipETag
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+
+	w.Header().Set("Content-Type", h.contentType)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	if matchesETag(r.Header.Get("If-None-Match"), h.etag, h.gzipETag) {
+		w.Header().Del("Content-Encoding")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	w.Write(body)
+}
+
+func acceptsGzip(acceptEncoding string) bool {
+	for _, encoding := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			continue
+		}
+
+		quality, found := strings.CutPrefix(strings.TrimSpace(params), "q=")
+		if !found {
+			return true
+		}
+
+		value, err := strconv.ParseFloat(quality, 64)
+		return err == nil && value > 0
+	}
+
+	return false
+}
+
+// matchesETag uses the weak comparison If-None-Match requires, so either encoding of the spec matches
+func matchesETag(ifNoneMatch string, etags ...string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" {
+			return true
+		}
+
+		for _, etag := range etags {
+			if candidate == etag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,564 @@
+package zeal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DandyCodes/zeal/breaking"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type RoutesOptions struct {
+	PackageName string
+	// ModelsImport is the import path of a package the models are generated into by GenerateModels,
+	// instead of alongside the routes
+	ModelsImport string
+}
+
+// GenerateRoutes generates route definitions, models and a Handlers interface from a spec,
+// along with a RegisterRoutes function which adds the routes to a mux
+func GenerateRoutes(spec *openapi3.T, options RoutesOptions) ([]byte, error) {
+	generator, err := newRoutesGenerator(spec, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by zeal. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %v\n\n", getPackageName(options))
+
+	source.WriteString("import (\n\t\"net/http\"\n")
+	if generator.routesUseTime || options.ModelsImport == "" && generator.modelsUseTime {
+		source.WriteString("\t\"time\"\n")
+	}
+	source.WriteString("\n\t\"github.com/DandyCodes/zeal\"\n")
+	if options.ModelsImport != "" {
+		fmt.Fprintf(&source, "\t%q\n", options.ModelsImport)
+	}
+	source.WriteString(")\n\n")
+
+	if options.ModelsImport == "" {
+		source.Write(generator.models.Bytes())
+		source.Write(generator.routes.Bytes())
+	} else {
+		source.Write(generator.routes.Bytes())
+	}
+
+	source.WriteString("type Handlers interface {\n")
+	for _, operation := range generator.operations {
+		fmt.Fprintf(&source, "\t%v(route *%vRoute, w http.ResponseWriter, r *http.Request) error\n", operation.name, operation.name)
+	}
+	source.WriteString("}\n\n")
+
+	source.WriteString("func RegisterRoutes(mux *zeal.ZealMux, handlers Handlers) {\n")
+	for _, operation := range generator.operations {
+		source.WriteString("\t{\n")
+		fmt.Fprintf(&source, "\t\troute := zeal.NewRoute[%vRoute](mux%v)\n", operation.name, operation.options)
+		fmt.Fprintf(&source, "\t\troute.HandleFuncErr(%q, func(w http.ResponseWriter, r *http.Request) error {\n", operation.pattern)
+		fmt.Fprintf(&source, "\t\t\treturn handlers.%v(route, w, r)\n", operation.name)
+		source.WriteString("\t\t})\n\t}\n")
+	}
+	source.WriteString("}\n")
+
+	return format.Source(source.Bytes())
+}
+
+// GenerateModels generates the models of a spec into their own package, for routes generated with ModelsImport
+func GenerateModels(spec *openapi3.T, options RoutesOptions) ([]byte, error) {
+	if options.ModelsImport == "" {
+		return nil, errors.New("expected ModelsImport, received none")
+	}
+
+	generator, err := newRoutesGenerator(spec, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by zeal. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %v\n\n", path.Base(options.ModelsImport))
+	if generator.modelsUseTime {
+		source.WriteString("import \"time\"\n\n")
+	}
+	source.Write(generator.models.Bytes())
+
+	return format.Source(source.Bytes())
+}
+
+// GenerateHandlerStubs generates a Server type implementing the Handlers interface of GenerateRoutes,
+// with every handler responding 501 Not Implemented
+func GenerateHandlerStubs(spec *openapi3.T, options RoutesOptions) ([]byte, error) {
+	generator, err := newRoutesGenerator(spec, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var source bytes.Buffer
+	fmt.Fprintf(&source, "package %v\n\n", getPackageName(options))
+	source.WriteString("import (\n\t\"net/http\"\n\n\t\"github.com/DandyCodes/zeal\"\n)\n\n")
+	source.WriteString("type Server struct{}\n\n")
+	source.WriteString("var _ Handlers = (*Server)(nil)\n")
+
+	for _, operation := range generator.operations {
+		source.WriteString("\n")
+		fmt.Fprintf(&source, "func (s *Server) %v(route *%vRoute, w http.ResponseWriter, r *http.Request) error {\n", operation.name, operation.name)
+		source.WriteString("\treturn zeal.Error(w, \"Not implemented\", http.StatusNotImplemented)\n}\n")
+	}
+
+	return format.Source(source.Bytes())
+}
+
+// VerifySpec reports how the mux's live spec differs from the expected spec, such as the spec its routes were generated from
+func VerifySpec(mux *ZealMux, expected *openapi3.T) error {
+	actual, err := mux.Spec()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, change := range breaking.Compare(expected, actual) {
+		errs = append(errs, fmt.Errorf("expected spec equivalent to the input, received change: %v", change))
+	}
+
+	return errors.Join(errs...)
+}
+
+func getPackageName(options RoutesOptions) string {
+	if options.PackageName == "" {
+		return "api"
+	}
+
+	return options.PackageName
+}
+
+type routeOperation struct {
+	name    string
+	pattern string
+	options string
+}
+
+type routesGenerator struct {
+	typeNames     map[string]string
+	usedNames     map[string]bool
+	modelNames    map[string]bool
+	modelsPackage string
+	defining      int
+	models        bytes.Buffer
+	routes        bytes.Buffer
+	operations    []routeOperation
+	modelsUseTime bool
+	routesUseTime bool
+	errs          []error
+}
+
+func newRoutesGenerator(spec *openapi3.T, options RoutesOptions) (*routesGenerator, error) {
+	g := &routesGenerator{typeNames: map[string]string{}, usedNames: map[string]bool{}, modelNames: map[string]bool{}}
+	if options.ModelsImport != "" {
+		g.modelsPackage = path.Base(options.ModelsImport)
+	}
+	for _, name := range []string{"Handlers", "RegisterRoutes", "Server"} {
+		g.usedNames[name] = true
+	}
+
+	var schemas openapi3.Schemas
+	if spec.Components != nil {
+		schemas = spec.Components.Schemas
+	}
+	for _, name := range getSortedKeys(schemas) {
+		g.typeNames[name] = g.newTypeName(toGoIdentifier(name))
+	}
+	for _, name := range getSortedKeys(schemas) {
+		g.defineType(g.typeNames[name], schemas[name].Value)
+	}
+
+	if spec.Paths != nil {
+		for _, path := range getSortedKeys(spec.Paths.Map()) {
+			pathItem := spec.Paths.Value(path)
+			for _, method := range tsClientMethods {
+				if operation := pathItem.GetOperation(method); operation != nil {
+					g.addOperation(method, path, pathItem, operation)
+				}
+			}
+		}
+	}
+
+	return g, errors.Join(g.errs...)
+}
+
+func (g *routesGenerator) newTypeName(name string) string {
+	if name == "" {
+		name = "Type"
+	}
+
+	typeName := name
+	for i := 2; g.usedNames[typeName]; i++ {
+		typeName = fmt.Sprintf("%v%d", name, i)
+	}
+	g.usedNames[typeName] = true
+
+	return typeName
+}
+
+func (g *routesGenerator) addError(location string, err error) {
+	g.errs = append(g.errs, fmt.Errorf("%v: %w", location, err))
+}
+
+func (g *routesGenerator) addOperation(method, path string, pathItem *openapi3.PathItem, operation *openapi3.Operation) {
+	location := method + " " + path
+	name := g.newTypeName(getGoClientMethodName(RouteInfo{Method: method, Path: path, OperationID: operation.OperationID}))
+	g.usedNames[name+"Route"] = true
+
+	var definition strings.Builder
+	fmt.Fprintf(&definition, "type %vRoute struct {\n\tzeal.Route\n", name)
+
+	if paramsType := g.defineParams(location, name+"Params", pathItem.Parameters, operation.Parameters); paramsType != "" {
+		fmt.Fprintf(&definition, "\tzeal.HasParams[%v]\n", paramsType)
+	}
+
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		schema, err := getJSONSchema(operation.RequestBody.Value.Content)
+		if err != nil {
+			g.addError(location+" body", err)
+		} else {
+			fmt.Fprintf(&definition, "\tzeal.HasBody[%v]\n", g.routeType(location+" body", schema, name+"Body"))
+		}
+	}
+
+	if schema := g.getResponseSchema(location, operation.Responses); schema != nil {
+		fmt.Fprintf(&definition, "\tzeal.HasResponse[%v]\n", g.routeType(location+" response", schema, name+"Response"))
+	}
+
+	definition.WriteString("}\n\n")
+	g.routes.WriteString(definition.String())
+
+	// ServeMux treats a trailing slash as a prefix, which OpenAPI paths are not
+	pattern := method + " " + path
+	if strings.HasSuffix(path, "/") {
+		pattern += "{$}"
+	}
+
+	g.operations = append(g.operations, routeOperation{name: name, pattern: pattern, options: getRouteOptionsSource(operation)})
+}
+
+func getRouteOptionsSource(operation *openapi3.Operation) string {
+	var fields []string
+	if operation.OperationID != "" {
+		fields = append(fields, "OperationID: "+strconv.Quote(operation.OperationID))
+	}
+	if operation.Summary != "" {
+		fields = append(fields, "Summary: "+strconv.Quote(operation.Summary))
+	}
+	if operation.Description != "" {
+		fields = append(fields, "Description: "+strconv.Quote(operation.Description))
+	}
+	if len(operation.Tags) > 0 {
+		tags := make([]string, len(operation.Tags))
+		for i, tag := range operation.Tags {
+			tags[i] = strconv.Quote(tag)
+		}
+		fields = append(fields, "Tags: []string{"+strings.Join(tags, ", ")+"}")
+	}
+	if operation.Deprecated {
+		fields = append(fields, "Deprecated: true")
+	}
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return ", zeal.RouteOptions{" + strings.Join(fields, ", ") + "}"
+}
+
+func (g *routesGenerator) defineParams(location, typeName string, pathParameters, operationParameters openapi3.Parameters) string {
+	var parameters []*openapi3.Parameter
+	for _, parameterRefs := range []openapi3.Parameters{pathParameters, operationParameters} {
+		for _, parameterRef := range parameterRefs {
+			if parameterRef.Value == nil {
+				continue
+			}
+
+			parameters = slices.DeleteFunc(parameters, func(existing *openapi3.Parameter) bool {
+				return existing.Name == parameterRef.Value.Name && existing.In == parameterRef.Value.In
+			})
+			parameters = append(parameters, parameterRef.Value)
+		}
+	}
+
+	if len(parameters) == 0 {
+		return ""
+	}
+
+	var fields strings.Builder
+	for _, parameter := range parameters {
+		paramLocation := fmt.Sprintf("%v %v param %v", location, parameter.In, parameter.Name)
+		if parameter.In != openapi3.ParameterInPath && parameter.In != openapi3.ParameterInQuery {
+			g.addError(paramLocation, fmt.Errorf("expected path or query param, received: %v", parameter.In))
+			continue
+		}
+
+		// Params are bound to the field with the same name, which must be exported
+		if !token.IsIdentifier(parameter.Name) || !token.IsExported(parameter.Name) {
+			g.addError(paramLocation, fmt.Errorf("expected param name which is an exported Go identifier, received: %v", parameter.Name))
+			continue
+		}
+
+		if parameter.In == openapi3.ParameterInQuery && !parameter.Required {
+			g.addError(paramLocation, errors.New("expected required query param, as zeal requires every query param, received optional"))
+			continue
+		}
+
+		paramType := g.goType(paramLocation, parameter.Schema, typeName+parameter.Name)
+		if !slices.Contains(goParamTypes, paramType) {
+			g.addError(paramLocation, fmt.Errorf("expected string, integer, number or boolean param, received: %v", paramType))
+			continue
+		}
+
+		fmt.Fprintf(&fields, "\t%v %v%v\n", parameter.Name, g.qualify(paramType), getFieldTags(nil, parameter.Description, parameter.Deprecated))
+	}
+
+	typeName = g.newTypeName(typeName)
+	fmt.Fprintf(&g.routes, "type %v struct {\n%v}\n\n", typeName, fields.String())
+
+	return typeName
+}
+
+// routeType is the type of a route's body or response, which can be time.Time without any model using it
+func (g *routesGenerator) routeType(location string, schemaRef *openapi3.SchemaRef, nameHint string) string {
+	goType := g.goType(location, schemaRef, nameHint)
+	if strings.Contains(goType, "time.Time") {
+		g.routesUseTime = true
+	}
+
+	return g.qualify(goType)
+}
+
+// qualify refers to the models in a type through their package, when routes are generated apart from them
+func (g *routesGenerator) qualify(goType string) string {
+	if g.modelsPackage == "" {
+		return goType
+	}
+
+	return goTypeNamePattern.ReplaceAllStringFunc(goType, func(name string) string {
+		if !g.modelNames[name] {
+			return name
+		}
+
+		return g.modelsPackage + "." + name
+	})
+}
+
+// goTypeNamePattern matches the names in a type, including qualified names like time.Time which are left as they are
+var goTypeNamePattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_.]*`)
+
+var goParamTypes = []string{"string", "bool", "int", "int32", "int64", "float32", "float64"}
+
+func getJSONSchema(content openapi3.Content) (*openapi3.SchemaRef, error) {
+	mediaType := getJSONMediaType(content)
+	if mediaType == nil {
+		return nil, fmt.Errorf("expected JSON content, received: %v", strings.Join(getSortedKeys(content), ", "))
+	}
+
+	return mediaType.Schema, nil
+}
+
+func (g *routesGenerator) getResponseSchema(location string, responses *openapi3.Responses) *openapi3.SchemaRef {
+	if responses == nil {
+		return nil
+	}
+
+	for _, status := range getSortedKeys(responses.Map()) {
+		response := responses.Value(status)
+		if !strings.HasPrefix(status, "2") || response.Value == nil || len(response.Value.Content) == 0 {
+			continue
+		}
+
+		schema, err := getJSONSchema(response.Value.Content)
+		if err != nil {
+			g.addError(location+" response "+status, err)
+			return nil
+		}
+
+		return schema
+	}
+
+	return nil
+}
+
+func (g *routesGenerator) defineType(typeName string, schema *openapi3.Schema) {
+	g.modelNames[typeName] = true
+	g.defining++
+	defer func() { g.defining-- }()
+
+	if schema == nil {
+		fmt.Fprintf(&g.models, "type %v any\n\n", typeName)
+		return
+	}
+
+	writeGoDoc(&g.models, schema.Description)
+
+	switch {
+	case len(schema.Enum) > 0 && schema.Type.Is(openapi3.TypeString):
+		fmt.Fprintf(&g.models, "type %v string\n\nconst (\n", typeName)
+		var constNames []string
+		for _, value := range schema.Enum {
+			value, _ := value.(string)
+			constName := g.newTypeName(typeName + toGoIdentifier(value))
+			constNames = append(constNames, constName)
+			fmt.Fprintf(&g.models, "\t%v %v = %q\n", constName, typeName, value)
+		}
+		g.models.WriteString(")\n\n")
+		fmt.Fprintf(&g.models, "func (%[1]v) Enum() []%[1]v {\n\treturn []%[1]v{%[2]v}\n}\n\n", typeName, strings.Join(constNames, ", "))
+	case len(schema.Properties) > 0:
+		g.defineStruct(typeName, schema)
+	default:
+		fmt.Fprintf(&g.models, "type %v %v\n\n", typeName, g.goType(typeName, openapi3.NewSchemaRef("", schema), typeName+"Value"))
+	}
+}
+
+func (g *routesGenerator) defineStruct(typeName string, schema *openapi3.Schema) {
+	var fields strings.Builder
+	fieldNames := map[string]bool{}
+	for _, name := range getSortedKeys(schema.Properties) {
+		property := schema.Properties[name]
+
+		fieldName := toGoIdentifier(name)
+		for i := 2; fieldName == "" || fieldNames[fieldName]; i++ {
+			fieldName = fmt.Sprintf("%v%d", toGoIdentifier(name), i)
+		}
+		fieldNames[fieldName] = true
+
+		fieldType := g.goType(typeName+"."+name, property, typeName+fieldName)
+		var description string
+		var deprecated bool
+		if property.Value != nil {
+			description, deprecated = property.Value.Description, property.Value.Deprecated
+			if property.Value.Nullable && !strings.HasPrefix(fieldType, "[]") && !strings.HasPrefix(fieldType, "map[") {
+				fieldType = "*" + fieldType
+			}
+		}
+
+		jsonName := name
+		if jsonName == fieldName {
+			jsonName = ""
+		}
+
+		var jsonOptions []string
+		required := slices.Contains(schema.Required, name)
+		isPointer := strings.HasPrefix(fieldType, "*")
+		if !required && !isPointer {
+			jsonOptions = append(jsonOptions, "omitempty")
+		}
+
+		var tags []string
+		if jsonName != "" || len(jsonOptions) > 0 {
+			tags = append(tags, fmt.Sprintf("json:%q", strings.Join(append([]string{jsonName}, jsonOptions...), ",")))
+		}
+		if required && isPointer {
+			tags = append(tags, `required:"true"`)
+		}
+
+		fmt.Fprintf(&fields, "\t%v %v%v\n", fieldName, fieldType, getFieldTags(tags, description, deprecated))
+	}
+
+	fmt.Fprintf(&g.models, "type %v struct {\n%v}\n\n", typeName, fields.String())
+}
+
+func getFieldTags(tags []string, description string, deprecated bool) string {
+	if description != "" {
+		tags = append(tags, fmt.Sprintf("description:%q", strings.ReplaceAll(description, "\n", " ")))
+	}
+	if deprecated {
+		tags = append(tags, `deprecated:"true"`)
+	}
+
+	if len(tags) == 0 {
+		return ""
+	}
+
+	return " `" + strings.Join(tags, " ") + "`"
+}
+
+func (g *routesGenerator) goType(location string, schemaRef *openapi3.SchemaRef, nameHint string) string {
+	if schemaRef == nil {
+		return "any"
+	}
+
+	if schemaRef.Ref != "" {
+		name := strings.TrimPrefix(schemaRef.Ref, "#/components/schemas/")
+		if typeName, ok := g.typeNames[name]; ok {
+			return typeName
+		}
+
+		g.addError(location, fmt.Errorf("expected reference to a component schema, received: %v", schemaRef.Ref))
+		return "any"
+	}
+
+	schema := schemaRef.Value
+	if schema == nil {
+		return "any"
+	}
+
+	if len(schema.AllOf) == 1 && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0 {
+		return g.goType(location, schema.AllOf[0], nameHint)
+	}
+
+	if len(schema.AllOf) > 0 || len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		g.addError(location, errors.New("expected schema without oneOf, anyOf or allOf, as Go has no union types"))
+		return "any"
+	}
+
+	if len(schema.Enum) > 0 && schema.Type.Is(openapi3.TypeString) {
+		typeName := g.newTypeName(nameHint)
+		g.defineType(typeName, schema)
+		return typeName
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		if schema.Format == "date-time" {
+			g.modelsUseTime = g.modelsUseTime || g.defining > 0
+			return "time.Time"
+		}
+		return "string"
+	case schema.Type.Is(openapi3.TypeInteger):
+		if schema.Format == "int32" || schema.Format == "int64" {
+			return schema.Format
+		}
+		return "int"
+	case schema.Type.Is(openapi3.TypeNumber):
+		if schema.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return "bool"
+	case schema.Type.Is(openapi3.TypeArray):
+		return "[]" + g.goType(location+"[]", schema.Items, nameHint+"Item")
+	case len(schema.Properties) > 0:
+		typeName := g.newTypeName(nameHint)
+		g.defineType(typeName, schema)
+		return typeName
+	case schema.AdditionalProperties.Schema != nil:
+		return "map[string]" + g.goType(location+"{}", schema.AdditionalProperties.Schema, nameHint+"Value")
+	case schema.Type.Is(openapi3.TypeObject):
+		return "map[string]any"
+	default:
+		return "any"
+	}
+}
+
+func writeGoDoc(source *bytes.Buffer, doc string) {
+	for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
+		if line != "" {
+			source.WriteString("// " + strings.TrimSpace(line) + "\n")
+		}
+	}
+}
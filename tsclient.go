@@ -0,0 +1,536 @@
+package zeal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func GenerateTypeScriptClient(spec *openapi3.T) ([]byte, error) {
+	generator := &tsClientGenerator{spec: spec, typeNames: map[string]string{}}
+
+	var source bytes.Buffer
+	source.WriteString("// Code generated by zeal. DO NOT EDIT.\n\n")
+
+	if spec.Components != nil {
+		usedNames := map[string]bool{"ApiError": true, "Client": true, "ClientOptions": true, "RequestOptions": true}
+		for _, name := range getSortedKeys(spec.Components.Schemas) {
+			typeName := toGoIdentifier(name)
+			if typeName == "" {
+				typeName = "Schema"
+			}
+			for i := 2; usedNames[typeName]; i++ {
+				typeName = fmt.Sprintf("%v%d", toGoIdentifier(name), i)
+			}
+			usedNames[typeName] = true
+			generator.typeNames[name] = typeName
+		}
+
+		for _, name := range getSortedKeys(spec.Components.Schemas) {
+			schemaRef := spec.Components.Schemas[name]
+			if schemaRef.Value != nil {
+				writeTSDoc(&source, "", schemaRef.Value.Description)
+			}
+			fmt.Fprintf(&source, "export type %v = %v;\n\n", generator.typeNames[name], generator.tsType(schemaRef, ""))
+		}
+	}
+
+	source.WriteString(tsClientRuntime)
+
+	var operations []tsOperation
+	if spec.Paths != nil {
+		for _, path := range getSortedKeys(spec.Paths.Map()) {
+			pathItem := spec.Paths.Value(path)
+			for _, method := range tsClientMethods {
+				operation := pathItem.GetOperation(method)
+				if operation == nil {
+					continue
+				}
+
+				operations = append(operations, tsOperation{method: method, path: path, pathItem: pathItem, operation: operation})
+			}
+		}
+	}
+
+	methodNames := map[string]bool{"constructor": true, "request": true}
+	for _, operation := range operations {
+		name := operation.getName()
+		for i := 2; methodNames[name]; i++ {
+			name = fmt.Sprintf("%v%d", operation.getName(), i)
+		}
+		methodNames[name] = true
+
+		if err := generator.writeOperation(&source, name, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	source.WriteString("}\n")
+
+	return source.Bytes(), nil
+}
+
+var tsClientMethods = []string{
+	http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+	http.MethodOptions, http.MethodHead, http.MethodPatch, http.MethodTrace,
+}
+
+const tsClientRuntime = `export class ApiError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly body: unknown,
+  ) {
+    super(` + "`unexpected status ${status}`" + `);
+    this.name = "ApiError";
+  }
+}
+
+export type ClientOptions = {
+  baseUrl?: string;
+  fetch?: typeof fetch;
+  headers?: Record<string, string>;
+};
+
+export type RequestOptions = Omit<RequestInit, "method" | "body">;
+
+type QueryValue = string | number | boolean | null | undefined;
+
+export class Client {
+  private readonly baseUrl: string;
+  private readonly fetch: typeof fetch;
+  private readonly headers: Record<string, string>;
+
+  constructor(options: ClientOptions = {}) {
+    this.baseUrl = options.baseUrl ?? "";
+    this.fetch = options.fetch ?? globalThis.fetch.bind(globalThis);
+    this.headers = options.headers ?? {};
+  }
+
+  private async request<T>(
+    method: string,
+    path: string,
+    query: Record<string, QueryValue | QueryValue[]>,
+    headers: Record<string, QueryValue>,
+    body: unknown,
+    options: RequestOptions = {},
+  ): Promise<T> {
+    const search = new URLSearchParams();
+    for (const [name, value] of Object.entries(query)) {
+      for (const item of Array.isArray(value) ? value : [value]) {
+        if (item !== undefined && item !== null) {
+          search.append(name, String(item));
+        }
+      }
+    }
+
+    const requestHeaders = new Headers(options.headers);
+    for (const [name, value] of Object.entries({ ...this.headers, ...headers })) {
+      if (value !== undefined && value !== null) {
+        requestHeaders.set(name, String(value));
+      }
+    }
+    requestHeaders.set("Accept", "application/json");
+    if (body !== undefined) {
+      requestHeaders.set("Content-Type", "application/json");
+    }
+
+    const queryString = search.toString();
+    const response = await this.fetch(this.baseUrl + path + (queryString ? "?" + queryString : ""), {
+      ...options,
+      method,
+      headers: requestHeaders,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+
+    const text = await response.text();
+    let data: unknown = text;
+    if (text && response.headers.get("Content-Type")?.includes("json")) {
+      data = JSON.parse(text);
+    }
+
+    if (!response.ok) {
+      throw new ApiError(response.status, data);
+    }
+
+    return data as T;
+  }
+`
+
+type tsOperation struct {
+	method    string
+	path      string
+	pathItem  *openapi3.PathItem
+	operation *openapi3.Operation
+}
+
+func (o tsOperation) getName() string {
+	name := o.operation.OperationID
+	if name == "" {
+		name = strings.ToLower(o.method)
+		for _, segment := range strings.Split(o.path, "/") {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				segment = "By " + strings.Trim(segment, "{}")
+			}
+			name += " " + segment
+		}
+	}
+
+	name = toGoIdentifier(name)
+	if name == "" {
+		return "call"
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+type tsClientGenerator struct {
+	spec      *openapi3.T
+	typeNames map[string]string
+}
+
+func (g *tsClientGenerator) writeOperation(source *bytes.Buffer, name string, o tsOperation) error {
+	parameters := slices.Clone(o.pathItem.Parameters)
+	for _, parameterRef := range o.operation.Parameters {
+		parameters = slices.DeleteFunc(parameters, func(existing *openapi3.ParameterRef) bool {
+			return existing.Value != nil && parameterRef.Value != nil &&
+				existing.Value.Name == parameterRef.Value.Name && existing.Value.In == parameterRef.Value.In
+		})
+		parameters = append(parameters, parameterRef)
+	}
+
+	var paramFields, queryFields, headerFields []string
+	substituted := make(map[string]bool)
+	hasRequiredParams := false
+	pathExpression := "`" + o.path + "`"
+	for _, parameterRef := range parameters {
+		parameter := parameterRef.Value
+		if parameter == nil {
+			continue
+		}
+
+		accessor := "params" + getTSAccessor(parameter.Name)
+		switch parameter.In {
+		case openapi3.ParameterInPath:
+			pathExpression = strings.ReplaceAll(pathExpression, "{"+parameter.Name+"}", "${encodeURIComponent(String("+accessor+"))}")
+			substituted[parameter.Name] = true
+		case openapi3.ParameterInQuery:
+			queryFields = append(queryFields, fmt.Sprintf("%v: %v", getTSPropertyName(parameter.Name), accessor))
+		case openapi3.ParameterInHeader:
+			headerFields = append(headerFields, fmt.Sprintf("%v: %v", getTSPropertyName(parameter.Name), accessor))
+		default:
+			continue
+		}
+
+		required := parameter.Required || parameter.In == openapi3.ParameterInPath
+		hasRequiredParams = hasRequiredParams || required
+
+		optional := "?"
+		if required {
+			optional = ""
+		}
+
+		var paramType string
+		if parameter.Schema != nil {
+			paramType = g.tsType(parameter.Schema, "  ")
+		} else {
+			paramType = "string"
+		}
+
+		var doc bytes.Buffer
+		writeTSDoc(&doc, "    ", parameter.Description)
+		paramFields = append(paramFields, fmt.Sprintf("%v    %v%v: %v;", doc.String(), getTSPropertyName(parameter.Name), optional, paramType))
+	}
+
+	for _, template := range tsPathTemplatePattern.FindAllStringSubmatch(o.path, -1) {
+		if !substituted[template[1]] {
+			return fmt.Errorf("expected path parameter %v for %v %v, received none", template[1], o.method, o.path)
+		}
+	}
+
+	var arguments []string
+	if len(paramFields) > 0 {
+		argument := fmt.Sprintf("params: {\n%v\n  }", strings.Join(paramFields, "\n"))
+		if !hasRequiredParams {
+			argument += " = {}"
+		}
+		arguments = append(arguments, argument)
+	}
+
+	bodyArgument := "undefined"
+	if o.operation.RequestBody != nil && o.operation.RequestBody.Value != nil {
+		requestBody := o.operation.RequestBody.Value
+		mediaType := getJSONMediaType(requestBody.Content)
+		if mediaType != nil {
+			bodyType := "unknown"
+			if mediaType.Schema != nil {
+				bodyType = g.tsType(mediaType.Schema, "  ")
+			}
+
+			if requestBody.Required {
+				arguments = append(arguments, "body: "+bodyType)
+			} else {
+				arguments = append(arguments, "body?: "+bodyType)
+			}
+			bodyArgument = "body"
+		}
+	}
+	arguments = append(arguments, "options?: RequestOptions")
+
+	source.WriteString("\n")
+	description := o.operation.Summary
+	if o.operation.Description != "" {
+		description = strings.TrimSpace(description + "\n\n" + o.operation.Description)
+	}
+	writeTSDoc(source, "  ", strings.TrimSpace(description+"\n\n"+o.method+" "+o.path))
+	if o.operation.Deprecated {
+		source.WriteString("  /** @deprecated */\n")
+	}
+
+	fmt.Fprintf(source, "  async %v(%v): Promise<%v> {\n", name, strings.Join(arguments, ", "), g.getResponseType(o.operation))
+	fmt.Fprintf(source, "    return this.request(%q, %v, {%v}, {%v}, %v, options);\n", o.method, pathExpression,
+		strings.Join(queryFields, ", "), strings.Join(headerFields, ", "), bodyArgument)
+	source.WriteString("  }\n")
+
+	return nil
+}
+
+func (g *tsClientGenerator) getResponseType(operation *openapi3.Operation) string {
+	if operation.Responses == nil {
+		return "void"
+	}
+
+	for _, status := range getSortedKeys(operation.Responses.Map()) {
+		if !strings.HasPrefix(status, "2") {
+			continue
+		}
+
+		response := operation.Responses.Value(status)
+		if response == nil || response.Value == nil {
+			continue
+		}
+
+		mediaType := getJSONMediaType(response.Value.Content)
+		if mediaType == nil || mediaType.Schema == nil {
+			return "void"
+		}
+
+		return g.tsType(mediaType.Schema, "")
+	}
+
+	return "void"
+}
+
+func getJSONMediaType(content openapi3.Content) *openapi3.MediaType {
+	for _, contentType := range getSortedKeys(content) {
+		if strings.Contains(contentType, "json") {
+			return content[contentType]
+		}
+	}
+
+	return nil
+}
+
+func (g *tsClientGenerator) tsType(schemaRef *openapi3.SchemaRef, indent string) string {
+	if schemaRef == nil {
+		return "unknown"
+	}
+
+	if schemaRef.Ref != "" {
+		name := strings.TrimPrefix(schemaRef.Ref, "#/components/schemas/")
+		typeName, ok := g.typeNames[name]
+		if !ok {
+			return "unknown"
+		}
+
+		if schemaRef.Value != nil && schemaRef.Value.Nullable {
+			return typeName + " | null"
+		}
+
+		return typeName
+	}
+
+	schema := schemaRef.Value
+	if schema == nil {
+		return "unknown"
+	}
+
+	tsType := g.tsSchemaType(schema, indent)
+	if schema.Nullable && tsType != "unknown" && tsType != "null" {
+		return tsType + " | null"
+	}
+
+	return tsType
+}
+
+func (g *tsClientGenerator) tsSchemaType(schema *openapi3.Schema, indent string) string {
+	if len(schema.Enum) > 0 {
+		var literals []string
+		for _, value := range schema.Enum {
+			literal, err := json.Marshal(value)
+			if err == nil {
+				literals = append(literals, string(literal))
+			}
+		}
+
+		return strings.Join(literals, " | ")
+	}
+
+	if len(schema.OneOf) > 0 {
+		return g.tsTypes(schema.OneOf, " | ", indent)
+	}
+
+	if len(schema.AnyOf) > 0 {
+		return g.tsTypes(schema.AnyOf, " | ", indent)
+	}
+
+	if len(schema.AllOf) > 0 {
+		if len(schema.AllOf) == 1 {
+			return g.tsType(schema.AllOf[0], indent)
+		}
+
+		return g.tsTypes(schema.AllOf, " & ", indent)
+	}
+
+	if schema.Type == nil || len(schema.Type.Slice()) == 0 {
+		if len(schema.Properties) > 0 {
+			return g.tsObjectType(schema, indent)
+		}
+
+		return "unknown"
+	}
+
+	var types []string
+	for _, schemaType := range schema.Type.Slice() {
+		switch schemaType {
+		case openapi3.TypeString:
+			types = append(types, "string")
+		case openapi3.TypeInteger, openapi3.TypeNumber:
+			types = append(types, "number")
+		case openapi3.TypeBoolean:
+			types = append(types, "boolean")
+		case openapi3.TypeNull:
+			types = append(types, "null")
+		case openapi3.TypeArray:
+			types = append(types, "Array<"+g.tsType(schema.Items, indent)+">")
+		case openapi3.TypeObject:
+			types = append(types, g.tsObjectType(schema, indent))
+		default:
+			types = append(types, "unknown")
+		}
+	}
+
+	return strings.Join(slices.Compact(types), " | ")
+}
+
+func (g *tsClientGenerator) tsTypes(schemaRefs openapi3.SchemaRefs, separator, indent string) string {
+	var types []string
+	for _, schemaRef := range schemaRefs {
+		tsType := g.tsType(schemaRef, indent)
+		if strings.Contains(tsType, " | ") || strings.Contains(tsType, " & ") {
+			tsType = "(" + tsType + ")"
+		}
+		types = append(types, tsType)
+	}
+
+	return strings.Join(types, separator)
+}
+
+func (g *tsClientGenerator) tsObjectType(schema *openapi3.Schema, indent string) string {
+	var additional string
+	if schema.AdditionalProperties.Schema != nil {
+		additional = "Record<string, " + g.tsType(schema.AdditionalProperties.Schema, indent) + ">"
+	} else if schema.AdditionalProperties.Has != nil && *schema.AdditionalProperties.Has {
+		additional = "Record<string, unknown>"
+	}
+
+	if len(schema.Properties) == 0 {
+		if additional != "" {
+			return additional
+		}
+
+		return "Record<string, unknown>"
+	}
+
+	var object strings.Builder
+	object.WriteString("{\n")
+	for _, name := range getSortedKeys(schema.Properties) {
+		property := schema.Properties[name]
+		if property.Value != nil {
+			writeTSDoc(&object, indent+"  ", property.Value.Description)
+			if property.Value.Deprecated {
+				fmt.Fprintf(&object, "%v  /** @deprecated */\n", indent)
+			}
+		}
+
+		optional := "?"
+		if slices.Contains(schema.Required, name) {
+			optional = ""
+		}
+
+		fmt.Fprintf(&object, "%v  %v%v: %v;\n", indent, getTSPropertyName(name), optional, g.tsType(property, indent+"  "))
+	}
+	fmt.Fprintf(&object, "%v}", indent)
+
+	if additional != "" {
+		return object.String() + " & " + additional
+	}
+
+	return object.String()
+}
+
+var tsIdentifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+var tsPathTemplatePattern = regexp.MustCompile(`\{([^}]*)\}`)
+
+func getTSPropertyName(name string) string {
+	if tsIdentifierPattern.MatchString(name) {
+		return name
+	}
+
+	quoted, _ := json.Marshal(name)
+	return string(quoted)
+}
+
+func getTSAccessor(name string) string {
+	if tsIdentifierPattern.MatchString(name) {
+		return "." + name
+	}
+
+	return "[" + getTSPropertyName(name) + "]"
+}
+
+func writeTSDoc(w interface{ WriteString(string) (int, error) }, indent, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+
+	doc = strings.ReplaceAll(doc, "*/", "*\\/")
+	lines := strings.Split(doc, "\n")
+	if len(lines) == 1 {
+		w.WriteString(indent + "/** " + lines[0] + " */\n")
+		return
+	}
+
+	w.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		w.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	w.WriteString(indent + " */\n")
+}
+
+func getSortedKeys[T_Value any](values map[string]T_Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
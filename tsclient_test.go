@@ -0,0 +1,40 @@
+package zeal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func newTSClientTestSpec(pathParams ...string) *openapi3.T {
+	operation := openapi3.NewOperation()
+	operation.OperationID = "getItem"
+	operation.AddResponse(200, openapi3.NewResponse().WithDescription("OK"))
+	for _, name := range pathParams {
+		operation.AddParameter(openapi3.NewPathParameter(name).WithSchema(openapi3.NewStringSchema()))
+	}
+
+	spec := &openapi3.T{OpenAPI: "3.0.0", Info: &openapi3.Info{Title: "API", Version: "1"}, Paths: openapi3.NewPaths()}
+	spec.AddOperation("/shops/{shop}/items/{item}", "GET", operation)
+
+	return spec
+}
+
+func TestTypeScriptClientSubstitutesEveryPathParameter(t *testing.T) {
+	source, err := GenerateTypeScriptClient(newTSClientTestSpec("shop", "item"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "`/shops/${encodeURIComponent(String(params.shop))}/items/${encodeURIComponent(String(params.item))}`"
+	if !strings.Contains(string(source), expected) {
+		t.Fatalf("expected path %v in the client, received:\n%s", expected, source)
+	}
+
+	for _, pathParams := range [][]string{{"shop"}, nil} {
+		if _, err := GenerateTypeScriptClient(newTSClientTestSpec(pathParams...)); err == nil {
+			t.Errorf("expected an error for undocumented path parameters with %v documented, received none", pathParams)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package zeal
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+func ValidateRequests(mux *ZealMux, openAPISpec *openapi3.T) (func(http.Handler) http.Handler, error) {
+	validator := &requestValidator{mux: mux, fixedSpec: openAPISpec}
+
+	// A fixed spec never changes, so a router which can't be built is reported once instead of on every request
+	if openAPISpec != nil {
+		if _, err := validator.getRouter(); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			router, err := validator.getRouter()
+			if err != nil {
+				Problem(w, "Failed to create OpenAPI spec", http.StatusInternalServerError)
+				return
+			}
+
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := validateRequest(r, route, pathParams); err != nil {
+				Problem(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+type requestValidator struct {
+	mux       *ZealMux
+	fixedSpec *openapi3.T
+	mu        sync.Mutex
+	spec      *openapi3.T
+	router    routers.Router
+}
+
+// getRouter finds operations in the spec, and is recreated when the live spec is rebuilt
+func (v *requestValidator) getRouter() (routers.Router, error) {
+	spec := v.fixedSpec
+	if spec == nil {
+		var err error
+		if spec, err = v.mux.Spec(); err != nil {
+			return nil, err
+		}
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if spec != v.spec {
+		router, err := legacy.NewRouter(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		v.spec, v.router = spec, router
+	}
+
+	return v.router, nil
+}
+
+func (m *ZealMux) ValidateRequest(info RouteInfo, r *http.Request) error {
+	route, err := m.getSpecRoute(info)
+	if err != nil {
+		return err
+	}
+
+	return validateRequest(r, route, matchPathParams(info.Path, r.URL.EscapedPath()))
+}
+
+// matchPathParams matches the wildcards of a route's path, which are whole segments, with the request's path
+func matchPathParams(routePath, requestPath string) map[string]string {
+	pathParams := map[string]string{}
+	requestSegments := strings.Split(requestPath, "/")
+	for i, segment := range strings.Split(routePath, "/") {
+		if i >= len(requestSegments) {
+			break
+		}
+
+		name, found := strings.CutPrefix(segment, "{")
+		if !found || segment == "{$}" {
+			continue
+		}
+		name = strings.TrimSuffix(name, "}")
+
+		value := requestSegments[i]
+		if rest, isRest := strings.CutSuffix(name, "..."); isRest {
+			name, value = rest, strings.Join(requestSegments[i:], "/")
+		}
+
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			value = unescaped
+		}
+		pathParams[name] = value
+	}
+
+	return pathParams
+}
+
+func validateRequest(r *http.Request, route *routers.Route, pathParams map[string]string) error {
+	// Security is enforced by the routes, and defaults must not be written into the body
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		SkipSettingDefaults: true,
+	}
+	options.WithCustomSchemaErrorFunc(getSchemaErrorMessage)
+
+	return openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    options,
+	})
+}
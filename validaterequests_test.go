@@ -0,0 +1,147 @@
+package zeal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const validateRequestsSpec = `
+openapi: 3.0.0
+info:
+  title: API
+  version: "1"
+paths:
+  /items/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+        - name: limit
+          in: query
+          required: true
+          schema:
+            type: integer
+            maximum: 100
+        - name: X-Version
+          in: header
+          required: true
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: OK
+  /items:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name:
+                  type: string
+      responses:
+        "200":
+          description: OK
+`
+
+func TestValidateRequests(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(validateRequestsSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validate, err := ValidateRequests(NewZealMux(http.NewServeMux()), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		version string
+		body    string
+		status  int
+	}{
+		{"valid params", http.MethodGet, "/items/1?limit=10", "2", "", http.StatusOK},
+		{"invalid path param", http.MethodGet, "/items/abc?limit=10", "2", "", http.StatusUnprocessableEntity},
+		{"missing query param", http.MethodGet, "/items/1", "2", "", http.StatusUnprocessableEntity},
+		{"query param out of bounds", http.MethodGet, "/items/1?limit=1000", "2", "", http.StatusUnprocessableEntity},
+		{"missing header", http.MethodGet, "/items/1?limit=10", "", "", http.StatusUnprocessableEntity},
+		{"invalid header", http.MethodGet, "/items/1?limit=10", "latest", "", http.StatusUnprocessableEntity},
+		{"valid body", http.MethodPost, "/items", "", `{"name": "Pizza"}`, http.StatusOK},
+		{"missing body property", http.MethodPost, "/items", "", `{}`, http.StatusUnprocessableEntity},
+		{"malformed body", http.MethodPost, "/items", "", `{"name":`, http.StatusUnprocessableEntity},
+		{"missing body", http.MethodPost, "/items", "", "", http.StatusUnprocessableEntity},
+		{"undocumented path", http.MethodGet, "/legacy/anything", "", "not validated", http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			if test.body != "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			if test.version != "" {
+				r.Header.Set("X-Version", test.version)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.status {
+				t.Fatalf("expected status %v, received: %v %v", test.status, w.Code, w.Body.String())
+			}
+			if test.status == http.StatusUnprocessableEntity && w.Header().Get("Content-Type") != "application/problem+json" {
+				t.Fatalf("expected a problem+json response, received: %v", w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestValidateRequestsAgainstLiveSpec(t *testing.T) {
+	mux := NewZealMux(http.NewServeMux())
+	var route = NewRoute[struct {
+		Route
+		HasParams[struct {
+			ID int `in:"path"`
+		}]
+	}](mux)
+	route.HandleFunc("GET /items/{ID}", func(w http.ResponseWriter, r *http.Request) {})
+
+	validate, err := ValidateRequests(mux, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for target, status := range map[string]int{"/items/1": http.StatusOK, "/items/abc": http.StatusUnprocessableEntity, "/legacy": http.StatusOK} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+
+		if w.Code != status {
+			t.Errorf("expected status %v for %v, received: %v", status, target, w.Code)
+		}
+	}
+}
+
+func TestValidateRequestsRejectsInvalidFixedSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(strings.Replace(validateRequestsSpec, "  title: API\n", "", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ValidateRequests(NewZealMux(http.NewServeMux()), spec); err == nil {
+		t.Fatal("expected an error for an invalid spec, received none")
+	}
+}
@@ -0,0 +1,334 @@
+package zealtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+)
+
+// Fuzz seeds the fuzz test with valid, boundary and invalid params and bodies for every route of the mux,
+// built from their declared types, and calls the routes with each input through httptest.
+// An input fails if its handler panics, if it is invalid according to the spec but receives a 5xx response,
+// or if it receives a 2xx response which doesn't conform to the spec.
+func Fuzz(f *testing.F, mux *zeal.ZealMux, options ...RequestOptions) {
+	f.Helper()
+
+	routes := mux.Routes()
+	if len(routes) == 0 {
+		f.Fatal("expected routes to fuzz, received none")
+	}
+
+	for i, info := range routes {
+		paramsSeeds := getParamsSeeds(info.ParamsType)
+		bodySeeds := getBodySeeds(info.BodyType)
+
+		for _, params := range paramsSeeds {
+			f.Add(uint(i), params, bodySeeds[0])
+		}
+		for _, body := range bodySeeds[1:] {
+			f.Add(uint(i), paramsSeeds[0], body)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, route uint, params string, body []byte) {
+		info := routes[route%uint(len(routes))]
+		fuzzRoute(t, mux, info, params, body, options...)
+	})
+}
+
+func fuzzRoute(t *testing.T, mux *zeal.ZealMux, info zeal.RouteInfo, params string, body []byte, options ...RequestOptions) {
+	t.Helper()
+
+	// Malformed params are kept as far as they parse, as the fuzzer mutates them freely
+	values, _ := url.ParseQuery(params)
+	path, query := getPathAndQuery(info.Path, values)
+	target := "http://example.com" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if info.BodyType != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	request, err := http.NewRequest(info.Method, target, bodyReader)
+	if err != nil {
+		t.Skipf("skipping input which doesn't form a request: %v", err)
+	}
+	if info.BodyType != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	addHeaders(request, options...)
+
+	requestErr := mux.ValidateRequest(info, request)
+
+	recorder := httptest.NewRecorder()
+	serveRoute(t, mux, info, recorder, request)
+
+	if recorder.Code >= 500 && requestErr != nil {
+		t.Fatalf("expected a 4xx response from %v to invalid input, received: %v: input was invalid: %v", info.Pattern, recorder.Code, requestErr)
+	}
+
+	if recorder.Code >= 200 && recorder.Code <= 299 {
+		err := mux.ValidateResponse(info, request, recorder.Code, recorder.Header(), recorder.Body.Bytes())
+		if err != nil {
+			t.Fatalf("expected response from %v to conform to the spec, received: %v", info.Pattern, err)
+		}
+	}
+}
+
+func serveRoute(t *testing.T, mux *zeal.ZealMux, info zeal.RouteInfo, w http.ResponseWriter, r *http.Request) {
+	t.Helper()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			t.Fatalf("expected no panic from %v, received: %v", info.Pattern, recovered)
+		}
+	}()
+
+	mux.ServeHTTP(w, r)
+}
+
+// getParamsSeeds returns encoded params, the first of which is valid. Each of the rest varies one param.
+func getParamsSeeds(paramsType reflect.Type) []string {
+	if paramsType == nil || paramsType.Kind() != reflect.Struct {
+		return []string{""}
+	}
+
+	valid := url.Values{}
+	samples := map[string][]string{}
+	var names []string
+	for i := 0; i < paramsType.NumField(); i++ {
+		field := paramsType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		names = append(names, field.Name)
+		samples[field.Name] = getParamSamples(field.Type)
+		valid.Set(field.Name, samples[field.Name][0])
+	}
+
+	seeds := []string{valid.Encode()}
+	for _, name := range names {
+		for _, sample := range samples[name][1:] {
+			seed := cloneValues(valid)
+			seed.Set(name, sample)
+			seeds = append(seeds, seed.Encode())
+		}
+
+		seed := cloneValues(valid)
+		seed.Del(name)
+		seeds = append(seeds, seed.Encode())
+	}
+
+	return seeds
+}
+
+func cloneValues(values url.Values) url.Values {
+	cloned := url.Values{}
+	for name, nameValues := range values {
+		cloned[name] = append([]string{}, nameValues...)
+	}
+
+	return cloned
+}
+
+// getParamSamples returns a valid value of the type, followed by boundary and invalid values
+func getParamSamples(fieldType reflect.Type) []string {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	// The invalid samples of other types still apply to enums, which a valid member must lead
+	if enum := getEnumValues(fieldType); len(enum) > 0 {
+		return append([]string{fmt.Sprint(enum[0].Interface())}, getBaseParamSamples(fieldType)...)
+	}
+
+	return getBaseParamSamples(fieldType)
+}
+
+func getBaseParamSamples(fieldType reflect.Type) []string {
+	switch fieldType.Kind() {
+	case reflect.String:
+		return []string{"a", "", " ", strings.Repeat("a", 1024), "ü/?#%&="}
+	case reflect.Bool:
+		return []string{"true", "false", "", "maybe"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := uint(fieldType.Bits())
+		maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1))
+		minValue := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+		overflow := new(big.Int).Add(maxValue, big.NewInt(1))
+		return []string{"1", "0", "-1", maxValue.String(), minValue.String(), overflow.String(), "", "1.5", "a"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bits := uint(fieldType.Bits())
+		maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+		overflow := new(big.Int).Add(maxValue, big.NewInt(1))
+		return []string{"1", "0", maxValue.String(), overflow.String(), "-1", "", "a"}
+	case reflect.Float32, reflect.Float64:
+		maxValue := strconv.FormatFloat(math.MaxFloat64, 'g', -1, 64)
+		if fieldType.Kind() == reflect.Float32 {
+			maxValue = strconv.FormatFloat(math.MaxFloat32, 'g', -1, 32)
+		}
+		return []string{"1.5", "0", "-1.5", maxValue, "1e400", "", "NaN", "a"}
+	}
+
+	return []string{""}
+}
+
+// getBodySeeds returns JSON bodies, the first of which is valid. The rest are boundary and invalid bodies.
+func getBodySeeds(bodyType reflect.Type) [][]byte {
+	if bodyType == nil {
+		return [][]byte{nil}
+	}
+
+	valid, err := json.Marshal(getSampleValue(bodyType, false, 0).Interface())
+	if err != nil {
+		valid = []byte("{}")
+	}
+
+	seeds := [][]byte{valid}
+	for _, sample := range []reflect.Value{reflect.Zero(bodyType), getSampleValue(bodyType, true, 0)} {
+		if encoded, err := json.Marshal(sample.Interface()); err == nil {
+			seeds = append(seeds, encoded)
+		}
+	}
+
+	for _, invalid := range []string{"", "null", "[]", "{", `{"zealUnknownField":1}`, `"a"`} {
+		seeds = append(seeds, []byte(invalid))
+	}
+
+	// Mistype each property of the valid body in turn
+	var properties map[string]any
+	if json.Unmarshal(valid, &properties) == nil {
+		for _, name := range getSortedNames(properties) {
+			var mistyped any = "a"
+			if _, isString := properties[name].(string); isString {
+				mistyped = 1
+			}
+
+			seed := map[string]any{}
+			for key, value := range properties {
+				seed[key] = value
+			}
+			seed[name] = mistyped
+
+			if encoded, err := json.Marshal(seed); err == nil {
+				seeds = append(seeds, encoded)
+			}
+		}
+	}
+
+	return seeds
+}
+
+func getSortedNames(properties map[string]any) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// getSampleValue fills a value of the type, taking the first member of enums.
+// If boundary is set, it uses extreme numbers, long strings and the last member of enums instead.
+func getSampleValue(valueType reflect.Type, boundary bool, depth int) reflect.Value {
+	value := reflect.New(valueType).Elem()
+	if depth > 4 {
+		return value
+	}
+
+	if enum := getEnumValues(valueType); len(enum) > 0 {
+		value.Set(enum[0])
+		if boundary {
+			value.Set(enum[len(enum)-1])
+		}
+		return value
+	}
+
+	switch valueType.Kind() {
+	case reflect.String:
+		value.SetString("a")
+		if boundary {
+			value.SetString(strings.Repeat("ü", 1024))
+		}
+	case reflect.Bool:
+		value.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(1)
+		if boundary {
+			value.SetInt(-1 << (valueType.Bits() - 1))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(1)
+		if boundary {
+			value.SetUint(math.MaxUint64 >> (64 - valueType.Bits()))
+		}
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(1.5)
+		if boundary {
+			value.SetFloat(-math.MaxFloat32)
+		}
+	case reflect.Pointer:
+		value.Set(reflect.New(valueType.Elem()))
+		value.Elem().Set(getSampleValue(valueType.Elem(), boundary, depth+1))
+	case reflect.Slice:
+		value.Set(reflect.MakeSlice(valueType, 1, 1))
+		value.Index(0).Set(getSampleValue(valueType.Elem(), boundary, depth+1))
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			value.Index(i).Set(getSampleValue(valueType.Elem(), boundary, depth+1))
+		}
+	case reflect.Map:
+		if valueType.Key().Kind() == reflect.String {
+			value.Set(reflect.MakeMap(valueType))
+			value.SetMapIndex(reflect.ValueOf("a").Convert(valueType.Key()), getSampleValue(valueType.Elem(), boundary, depth+1))
+		}
+	case reflect.Struct:
+		for i := 0; i < valueType.NumField(); i++ {
+			if valueType.Field(i).IsExported() {
+				value.Field(i).Set(getSampleValue(valueType.Field(i).Type, boundary, depth+1))
+			}
+		}
+	}
+
+	return value
+}
+
+// getEnumValues returns the values of the type's Enum method, as getTypeEnum documents them in the spec
+func getEnumValues(enumType reflect.Type) []reflect.Value {
+	method := reflect.New(enumType).MethodByName("Enum")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 || method.Type().Out(0).Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := method.Call([]reflect.Value{})[0]
+	enum := make([]reflect.Value, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		value := values.Index(i)
+		if value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+		if value.IsValid() && value.Type().ConvertibleTo(enumType) {
+			enum = append(enum, value.Convert(enumType))
+		}
+	}
+
+	return enum
+}
@@ -0,0 +1,66 @@
+package zealtest
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+)
+
+type testSize string
+
+const (
+	testSizeSmall testSize = "small"
+	testSizeLarge testSize = "large"
+)
+
+func (testSize) Enum() []testSize {
+	return []testSize{testSizeSmall, testSizeLarge}
+}
+
+type testOrder = struct {
+	Name string
+	Size testSize
+}
+
+type postOrderRoute = struct {
+	zeal.Route
+	zeal.HasBody[testOrder]
+	zeal.HasResponse[testOrder]
+}
+
+func FuzzEchoMux(f *testing.F) {
+	mux := zeal.NewZealMux(http.NewServeMux())
+
+	postOrder := zeal.NewRoute[postOrderRoute](mux)
+	postOrder.HandleFuncErr("POST /orders", func(w http.ResponseWriter, r *http.Request) error {
+		order := postOrder.Body()
+		if !slices.Contains(order.Size.Enum(), order.Size) {
+			return zeal.Error(w, "Size is not one of its allowed values", http.StatusUnprocessableEntity)
+		}
+
+		return postOrder.Response(order)
+	})
+
+	Fuzz(f, mux)
+}
+
+func TestGetSampleValueTakesEnumMembers(t *testing.T) {
+	tests := []struct {
+		boundary bool
+		expected testOrder
+	}{
+		{false, testOrder{Name: "a", Size: testSizeSmall}},
+		{true, testOrder{Name: strings.Repeat("ü", 1024), Size: testSizeLarge}},
+	}
+
+	for _, test := range tests {
+		sample := getSampleValue(reflect.TypeFor[testOrder](), test.boundary, 0).Interface()
+		if sample != test.expected {
+			t.Fatalf("expected sample %+v with boundary %v, received: %+v", test.expected, test.boundary, sample)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Package zealtest calls the routes of a zeal.ZealMux in memory, using the types of their definitions
+package zealtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+)
+
+type Response struct {
+	Status int
+	Header http.Header
+	// Body holds a value of the route's response type, decoded from a 2xx response
+	Body any
+	// RawBody is the body as written by the handler
+	RawBody []byte
+}
+
+type RequestOptions struct {
+	// Header is added to the request, for example to authenticate it
+	Header http.Header
+}
+
+// Do calls the route defined by T_Route on the mux through httptest, and returns its response.
+// Params fill the route's path wildcards, with other fields sent as query params, and the body is sent as JSON.
+// Params and body must be of the route's declared types, or nil if it has none.
+func Do[T_Route any](t testing.TB, mux *zeal.ZealMux, params, body any, options ...RequestOptions) *Response {
+	t.Helper()
+
+	info, err := findRoute(mux, reflect.TypeFor[T_Route]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := newRequest(info, params, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addHeaders(request, options...)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	response := &Response{
+		Status:  recorder.Code,
+		Header:  recorder.Header(),
+		RawBody: recorder.Body.Bytes(),
+	}
+
+	if info.ResponseType == nil || response.Status < 200 || response.Status > 299 || len(response.RawBody) == 0 {
+		return response
+	}
+
+	value := reflect.New(info.ResponseType)
+	if err := json.Unmarshal(response.RawBody, value.Interface()); err != nil {
+		t.Fatalf("expected response of type %v from %v, received: %q: %v", info.ResponseType, info.Pattern, response.RawBody, err)
+	}
+	response.Body = value.Elem().Interface()
+
+	return response
+}
+
+func addHeaders(request *http.Request, options ...RequestOptions) {
+	if len(options) == 0 {
+		return
+	}
+
+	for name, values := range options[0].Header {
+		request.Header[name] = append(request.Header[name], values...)
+	}
+}
+
+func findRoute(mux *zeal.ZealMux, routeType reflect.Type) (zeal.RouteInfo, error) {
+	var found []zeal.RouteInfo
+	for _, info := range mux.Routes() {
+		if info.RouteType == routeType {
+			found = append(found, info)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return zeal.RouteInfo{}, fmt.Errorf("expected route of type %v, received none", routeType)
+	case 1:
+		return found[0], nil
+	}
+
+	patterns := make([]string, len(found))
+	for i, info := range found {
+		patterns[i] = info.Pattern
+	}
+
+	return zeal.RouteInfo{}, fmt.Errorf("expected one route of type %v, received: %v", routeType, strings.Join(patterns, ", "))
+}
+
+func newRequest(info zeal.RouteInfo, params, body any) (*http.Request, error) {
+	if err := checkType("params", info, info.ParamsType, params); err != nil {
+		return nil, err
+	}
+
+	if err := checkType("body", info, info.BodyType, body); err != nil {
+		return nil, err
+	}
+
+	path, query := getPathAndQuery(info.Path, getParamValues(params))
+
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	target := path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	request := httptest.NewRequest(info.Method, target, bodyReader)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	return request, nil
+}
+
+func checkType(name string, info zeal.RouteInfo, declared reflect.Type, value any) error {
+	if value == nil {
+		return nil
+	}
+
+	if declared == nil {
+		return fmt.Errorf("expected no %v for %v, received: %T", name, info.Pattern, value)
+	}
+
+	if reflect.TypeOf(value) != declared {
+		return fmt.Errorf("expected %v of type %v for %v, received: %T", name, declared, info.Pattern, value)
+	}
+
+	return nil
+}
+
+// getParamValues formats the exported fields of params, leaving out nil pointers
+func getParamValues(params any) url.Values {
+	values := url.Values{}
+
+	value := reflect.ValueOf(params)
+	if !value.IsValid() || value.Kind() != reflect.Struct {
+		return values
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if fieldValue.Kind() == reflect.Pointer {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+		values.Set(field.Name, fmt.Sprint(fieldValue.Interface()))
+	}
+
+	return values
+}
+
+// getPathAndQuery fills the pattern's wildcards with values of the same name, and returns the rest as a query
+func getPathAndQuery(pattern string, values url.Values) (string, url.Values) {
+	pattern = strings.TrimSuffix(pattern, "{$}")
+	query := url.Values{}
+
+	for name, nameValues := range values {
+		value := ""
+		if len(nameValues) > 0 {
+			value = nameValues[0]
+		}
+
+		switch {
+		case strings.Contains(pattern, "{"+name+"}"):
+			pattern = strings.ReplaceAll(pattern, "{"+name+"}", url.PathEscape(value))
+		case strings.Contains(pattern, "{"+name+"...}"):
+			pattern = strings.ReplaceAll(pattern, "{"+name+"...}", (&url.URL{Path: value}).EscapedPath())
+		default:
+			query[name] = nameValues
+		}
+	}
+
+	return pattern, query
+}
@@ -0,0 +1,159 @@
+package zealtest
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/DandyCodes/zeal"
+)
+
+type testItemParams = struct {
+	ID    int    `in:"path"`
+	Path  string `in:"path"`
+	Limit int
+	Query string
+}
+
+type testItem = struct {
+	Name  string
+	Price float64
+}
+
+type getItemRoute = struct {
+	zeal.Route
+	zeal.HasParams[testItemParams]
+	zeal.HasResponse[string]
+}
+
+type putItemRoute = struct {
+	zeal.Route
+	zeal.HasBody[testItem]
+	zeal.HasResponse[testItem]
+}
+
+func newTestMux() *zeal.ZealMux {
+	mux := zeal.NewZealMux(http.NewServeMux())
+
+	getItem := zeal.NewRoute[getItemRoute](mux)
+	getItem.HandleFunc("GET /items/{ID}/{Path...}", func(w http.ResponseWriter, r *http.Request) {
+		params := getItem.Params()
+		getItem.Response(fmt.Sprintf("%v %v %v %v", params.ID, params.Path, params.Limit, params.Query))
+	})
+
+	putItem := zeal.NewRoute[putItemRoute](mux)
+	putItem.HandleFuncErr("PUT /items", func(w http.ResponseWriter, r *http.Request) error {
+		item := putItem.Body()
+		if item.Price < 0 {
+			return zeal.Error(w, "Price cannot be negative", http.StatusBadRequest)
+		}
+
+		return putItem.Response(item, http.StatusCreated)
+	})
+
+	return mux
+}
+
+func TestDo(t *testing.T) {
+	mux := newTestMux()
+
+	tests := []struct {
+		name           string
+		params         testItemParams
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"path and rest wildcards", testItemParams{ID: 1, Path: "a/b c"}, http.StatusOK, "1 a/b c 0 "},
+		{"query params", testItemParams{ID: 2, Path: "a", Limit: 5, Query: "x&y=z"}, http.StatusOK, "2 a 5 x&y=z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := Do[getItemRoute](t, mux, test.params, nil)
+			if response.Status != test.expectedStatus {
+				t.Fatalf("expected status %v, received: %v: %s", test.expectedStatus, response.Status, response.RawBody)
+			}
+			if response.Body != test.expectedBody {
+				t.Fatalf("expected body %q, received: %q", test.expectedBody, response.Body)
+			}
+		})
+	}
+}
+
+func TestDoSendsJSONBody(t *testing.T) {
+	mux := newTestMux()
+
+	response := Do[putItemRoute](t, mux, nil, testItem{Name: "Pie", Price: 2.5})
+	if response.Status != http.StatusCreated {
+		t.Fatalf("expected status %v, received: %v: %s", http.StatusCreated, response.Status, response.RawBody)
+	}
+	if item, ok := response.Body.(testItem); !ok || item != (testItem{Name: "Pie", Price: 2.5}) {
+		t.Fatalf("expected body %+v, received: %#v", testItem{Name: "Pie", Price: 2.5}, response.Body)
+	}
+}
+
+func TestDoLeavesBodyOfErrorResponsesNil(t *testing.T) {
+	mux := newTestMux()
+
+	response := Do[putItemRoute](t, mux, nil, testItem{Name: "Pie", Price: -1})
+	if response.Status != http.StatusBadRequest {
+		t.Fatalf("expected status %v, received: %v", http.StatusBadRequest, response.Status)
+	}
+	if response.Body != nil {
+		t.Fatalf("expected nil body, received: %#v", response.Body)
+	}
+	if !strings.Contains(string(response.RawBody), "Price cannot be negative") {
+		t.Fatalf("expected raw body to hold the error, received: %q", response.RawBody)
+	}
+}
+
+// fatalRecorder records the failure of a test, stopping its goroutine as testing.T does
+type fatalRecorder struct {
+	testing.TB
+	message string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatal(args ...any) {
+	r.message = fmt.Sprint(args...)
+	runtime.Goexit()
+}
+
+func (r *fatalRecorder) Fatalf(format string, args ...any) {
+	r.message = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func TestDoFailsOnMismatchedTypes(t *testing.T) {
+	mux := newTestMux()
+
+	tests := []struct {
+		name            string
+		do              func(tb testing.TB)
+		expectedMessage string
+	}{
+		{"params", func(tb testing.TB) { Do[getItemRoute](tb, mux, struct{ ID int }{1}, nil) }, "expected params of type"},
+		{"body", func(tb testing.TB) { Do[putItemRoute](tb, mux, nil, "Pie") }, "expected body of type"},
+		{"unexpected body", func(tb testing.TB) { Do[getItemRoute](tb, mux, testItemParams{ID: 1}, testItem{}) }, "expected no body"},
+		{"unknown route", func(tb testing.TB) { Do[struct{ zeal.Route }](tb, mux, nil, nil) }, "expected route of type"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := &fatalRecorder{TB: t}
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				test.do(recorder)
+			}()
+			<-done
+
+			if !strings.Contains(recorder.message, test.expectedMessage) {
+				t.Fatalf("expected failure containing %q, received: %q", test.expectedMessage, recorder.message)
+			}
+		})
+	}
+}